@@ -0,0 +1,159 @@
+package dome9
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/dome9/dome9-sdk-go/services/assessment"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+// assessmentSeverities are the rule severities, from the lowest to the highest
+var assessmentSeverities = []string{"Informational", "Low", "Medium", "High", "Critical"}
+
+// maxReportedEntities is the count of non-complying entities listed per failed test
+const maxReportedEntities = 10
+
+// assessmentFailOnSchema returns the conditions making the apply of dome9_assessment fail
+func assessmentFailOnSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"severity": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice(assessmentSeverities, false),
+		},
+		"max_failed_tests": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      -1,
+			ValidateFunc: validation.IntAtLeast(-1),
+		},
+		"rule_ids": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+	}
+}
+
+// assessmentFailOn is the expanded fail_on of dome9_assessment
+type assessmentFailOn struct {
+	// severity is the index in assessmentSeverities of the lowest failing severity, -1 for none
+	severity       int
+	maxFailedTests int
+	ruleIDs        map[string]bool
+}
+
+func expandAssessmentFailOn(d *schema.ResourceData) *assessmentFailOn {
+	items := d.Get("fail_on").([]interface{})
+	if len(items) == 0 || items[0] == nil {
+		return nil
+	}
+	failOn := items[0].(map[string]interface{})
+
+	ruleIDs := make(map[string]bool)
+	for _, ruleID := range failOn["rule_ids"].(*schema.Set).List() {
+		ruleIDs[ruleID.(string)] = true
+	}
+
+	return &assessmentFailOn{
+		severity:       severityRank(failOn["severity"].(string)),
+		maxFailedTests: failOn["max_failed_tests"].(int),
+		ruleIDs:        ruleIDs,
+	}
+}
+
+// severityRank returns the index of severity in assessmentSeverities, -1 when it is unknown
+func severityRank(severity string) int {
+	for i, s := range assessmentSeverities {
+		if strings.EqualFold(s, severity) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// check returns an error summarizing the failed tests when the results of the assessment meet one of the conditions
+func (f *assessmentFailOn) check(resp *assessment.RunBundleResponse) error {
+	var failedTests []assessment.Test
+	for _, test := range resp.Tests {
+		if !test.TestPassed {
+			failedTests = append(failedTests, test)
+		}
+	}
+
+	var reasons []string
+	if f.maxFailedTests >= 0 && len(failedTests) > f.maxFailedTests {
+		reasons = append(reasons, fmt.Sprintf("%d failed tests, more than max_failed_tests %d", len(failedTests), f.maxFailedTests))
+	}
+
+	var gatingTests []assessment.Test
+	var severityFailures int
+	var ruleIDs []string
+	for _, test := range failedTests {
+		severityFailed := f.severity >= 0 && severityRank(test.Rule.Severity) >= f.severity
+		if severityFailed {
+			severityFailures++
+		}
+		if f.ruleIDs[test.Rule.RuleID] {
+			ruleIDs = append(ruleIDs, test.Rule.RuleID)
+		}
+		if severityFailed || f.ruleIDs[test.Rule.RuleID] {
+			gatingTests = append(gatingTests, test)
+		}
+	}
+	if severityFailures > 0 {
+		reasons = append(reasons, fmt.Sprintf("%d failed tests of severity %s or higher", severityFailures, assessmentSeverities[f.severity]))
+	}
+	if len(ruleIDs) > 0 {
+		sort.Strings(ruleIDs)
+		reasons = append(reasons, fmt.Sprintf("failed rule_ids %s", strings.Join(ruleIDs, ", ")))
+	}
+	if len(reasons) == 0 {
+		return nil
+	}
+
+	// the tests failing max_failed_tests only are all listed
+	if len(gatingTests) == 0 {
+		gatingTests = failedTests
+	}
+	sort.SliceStable(gatingTests, func(i, j int) bool {
+		return severityRank(gatingTests[i].Rule.Severity) > severityRank(gatingTests[j].Rule.Severity)
+	})
+
+	var summary strings.Builder
+	fmt.Fprintf(&summary, "assessment %d of ruleset %d failed fail_on: %s", resp.ID, resp.Request.BundleID, strings.Join(reasons, "; "))
+	for _, test := range gatingTests {
+		fmt.Fprintf(&summary, "\n\n[%s] %s", test.Rule.Severity, test.Rule.Name)
+		if test.Rule.RuleID != "" {
+			fmt.Fprintf(&summary, " (%s)", test.Rule.RuleID)
+		}
+		if test.Error != "" {
+			fmt.Fprintf(&summary, "\n  error: %s", test.Error)
+		}
+		fmt.Fprintf(&summary, "\n  %d non-complying of %d relevant entities", test.NonComplyingCount, test.RelevantCount)
+
+		entities := nonComplyingEntities(test)
+		for i, entity := range entities {
+			if i == maxReportedEntities {
+				fmt.Fprintf(&summary, "\n  - and %d more", len(entities)-i)
+				break
+			}
+			fmt.Fprintf(&summary, "\n  - %s %s", entity.EntityType, assessmentEntityName(entity))
+		}
+	}
+
+	return errors.New(summary.String())
+}
+
+func assessmentEntityName(entity assessment.RuleEngineFailedEntityReference) string {
+	if entity.Id != "" {
+		return entity.Id
+	}
+
+	return entity.Dome9Id
+}
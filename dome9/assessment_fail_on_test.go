@@ -0,0 +1,144 @@
+package dome9
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/dome9/dome9-sdk-go/services/assessment"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func failedAssessmentTest(ruleID, severity string, entityIDs ...string) assessment.Test {
+	test := assessment.Test{
+		Rule:              assessment.Rule{Name: "rule " + ruleID, RuleID: ruleID, Severity: severity},
+		RelevantCount:     len(entityIDs) + 1,
+		NonComplyingCount: len(entityIDs),
+	}
+	for _, id := range entityIDs {
+		test.EntityResults = append(test.EntityResults, assessment.EntityResult{
+			IsRelevant: true,
+			TestObj:    assessment.RuleEngineFailedEntityReference{Id: id, EntityType: "Instance"},
+		})
+	}
+	// a complying and an excluded entity, which aren't reported
+	test.EntityResults = append(test.EntityResults,
+		assessment.EntityResult{IsRelevant: true, IsValid: true, TestObj: assessment.RuleEngineFailedEntityReference{Id: "i-valid"}},
+		assessment.EntityResult{IsRelevant: true, IsExcluded: true, TestObj: assessment.RuleEngineFailedEntityReference{Id: "i-excluded"}},
+	)
+
+	return test
+}
+
+func TestAssessmentFailOnCheck(t *testing.T) {
+	resp := &assessment.RunBundleResponse{
+		ID:      7,
+		Request: assessment.Request{BundleID: 42},
+		Tests: []assessment.Test{
+			failedAssessmentTest("D9.T.1", "Low", "i-1"),
+			failedAssessmentTest("D9.T.2", "High", "i-2", "i-3"),
+			{Rule: assessment.Rule{RuleID: "D9.T.3", Severity: "Critical"}, TestPassed: true},
+		},
+	}
+
+	cases := []struct {
+		name   string
+		failOn assessmentFailOn
+		// want are the lines of the error, empty when the assessment passes
+		want []string
+		// notWant are the texts missing from the error
+		notWant []string
+	}{
+		{
+			name:   "passing thresholds",
+			failOn: assessmentFailOn{severity: severityRank("Critical"), maxFailedTests: 2, ruleIDs: map[string]bool{"D9.T.3": true}},
+		},
+		{
+			name:   "severity",
+			failOn: assessmentFailOn{severity: severityRank("Medium"), maxFailedTests: -1},
+			want: []string{
+				"assessment 7 of ruleset 42 failed fail_on: 1 failed tests of severity Medium or higher",
+				"[High] rule D9.T.2 (D9.T.2)",
+				"  2 non-complying of 3 relevant entities",
+				"  - Instance i-2",
+				"  - Instance i-3",
+			},
+		},
+		{
+			name:   "max failed tests",
+			failOn: assessmentFailOn{severity: -1, maxFailedTests: 1},
+			want: []string{
+				"assessment 7 of ruleset 42 failed fail_on: 2 failed tests, more than max_failed_tests 1",
+				"[High] rule D9.T.2 (D9.T.2)",
+				"[Low] rule D9.T.1 (D9.T.1)",
+				"  - Instance i-1",
+			},
+		},
+		{
+			name:   "rule ids",
+			failOn: assessmentFailOn{severity: -1, maxFailedTests: -1, ruleIDs: map[string]bool{"D9.T.1": true, "D9.T.3": true}},
+			want: []string{
+				"assessment 7 of ruleset 42 failed fail_on: failed rule_ids D9.T.1",
+				"[Low] rule D9.T.1 (D9.T.1)",
+			},
+			notWant: []string{"D9.T.2"},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := c.failOn.check(resp)
+			if len(c.want) == 0 {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+			for _, line := range c.want {
+				if !strings.Contains(err.Error()+"\n", line+"\n") {
+					t.Errorf("missing line %q in:\n%s", line, err)
+				}
+			}
+			// the complying and the excluded entities are never reported
+			for _, text := range append(c.notWant, "i-valid", "i-excluded") {
+				if strings.Contains(err.Error(), text) {
+					t.Errorf("unexpected %q in:\n%s", text, err)
+				}
+			}
+		})
+	}
+}
+
+func TestAssessmentFailOnCheckTruncatesEntities(t *testing.T) {
+	var ids []string
+	for i := 0; i < maxReportedEntities+3; i++ {
+		ids = append(ids, "i-"+string(rune('a'+i)))
+	}
+	resp := &assessment.RunBundleResponse{Tests: []assessment.Test{failedAssessmentTest("D9.T.1", "High", ids...)}}
+
+	err := (&assessmentFailOn{severity: severityRank("High"), maxFailedTests: -1}).check(resp)
+	if err == nil || !strings.Contains(err.Error(), "\n  - and 3 more") || strings.Contains(err.Error(), "Instance i-k") {
+		t.Fatalf("got %v, want the first %d entities and a count of the others", err, maxReportedEntities)
+	}
+}
+
+func TestExpandAssessmentFailOn(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceAssessment().Schema, map[string]interface{}{
+		"fail_on": []interface{}{map[string]interface{}{
+			"severity": "High",
+			"rule_ids": []interface{}{"D9.T.1"},
+		}},
+	})
+
+	failOn := expandAssessmentFailOn(d)
+	if failOn == nil || failOn.severity != severityRank("High") || failOn.maxFailedTests != -1 || !failOn.ruleIDs["D9.T.1"] {
+		t.Fatalf("got %+v", failOn)
+	}
+
+	d = schema.TestResourceDataRaw(t, resourceAssessment().Schema, map[string]interface{}{})
+	if failOn := expandAssessmentFailOn(d); failOn != nil {
+		t.Fatalf("got %+v, want nil without fail_on", failOn)
+	}
+}
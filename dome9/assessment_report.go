@@ -0,0 +1,388 @@
+package dome9
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/dome9/dome9-sdk-go/services/assessment"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+// Formats of the assessment reports
+const (
+	assessmentReportSARIF = "sarif"
+	assessmentReportJUnit = "junit"
+)
+
+// sarifSecuritySeverities are the scores GitHub code scanning ranks the rules by, by severity
+var sarifSecuritySeverities = map[string]string{
+	"Critical":      "9.5",
+	"High":          "8.0",
+	"Medium":        "5.5",
+	"Low":           "3.0",
+	"Informational": "0.0",
+}
+
+// assessmentReportSchema returns the arguments of a report file of dome9_assessment
+func assessmentReportSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"format": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice([]string{assessmentReportSARIF, assessmentReportJUnit}, false),
+		},
+		"path": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"artifact_uri": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+	}
+}
+
+// writeAssessmentReports writes the report files of the assessment
+func writeAssessmentReports(d *schema.ResourceData, resp *assessment.RunBundleResponse) error {
+	for _, item := range d.Get("report").([]interface{}) {
+		report := item.(map[string]interface{})
+		path := report["path"].(string)
+
+		var content []byte
+		var err error
+		switch report["format"].(string) {
+		case assessmentReportSARIF:
+			content, err = assessmentSARIF(resp, report["artifact_uri"].(string))
+		case assessmentReportJUnit:
+			content, err = assessmentJUnit(resp)
+		}
+		if err != nil {
+			return fmt.Errorf("failed building report %s: %w", path, err)
+		}
+
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			return fmt.Errorf("failed writing report %s: %w", path, err)
+		}
+		if err := ioutil.WriteFile(path, content, 0644); err != nil {
+			return fmt.Errorf("failed writing report %s: %w", path, err)
+		}
+	}
+
+	return nil
+}
+
+// nonComplyingEntities returns the entities failing the test, the excluded ones left out
+func nonComplyingEntities(test assessment.Test) []assessment.RuleEngineFailedEntityReference {
+	var entities []assessment.RuleEngineFailedEntityReference
+	for _, result := range test.EntityResults {
+		if result.IsRelevant && !result.IsValid && !result.IsExcluded {
+			entities = append(entities, result.TestObj)
+		}
+	}
+
+	return entities
+}
+
+// assessmentRuleID identifies the rule of a test, by its rule id, else its logic hash, else its name
+func assessmentRuleID(rule assessment.Rule) string {
+	switch {
+	case rule.RuleID != "":
+		return rule.RuleID
+	case rule.LogicHash != "":
+		return rule.LogicHash
+	}
+
+	return rule.Name
+}
+
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool        sarifTool              `json:"tool"`
+	Invocations []sarifInvocation      `json:"invocations"`
+	Results     []sarifResult          `json:"results"`
+	Properties  map[string]interface{} `json:"properties,omitempty"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	InformationURI string      `json:"informationUri"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID                   string                 `json:"id"`
+	Name                 string                 `json:"name,omitempty"`
+	ShortDescription     sarifMessage           `json:"shortDescription"`
+	FullDescription      *sarifMessage          `json:"fullDescription,omitempty"`
+	Help                 *sarifMessage          `json:"help,omitempty"`
+	DefaultConfiguration sarifConfiguration     `json:"defaultConfiguration"`
+	Properties           map[string]interface{} `json:"properties"`
+}
+
+type sarifConfiguration struct {
+	Level string `json:"level"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifInvocation struct {
+	ExecutionSuccessful        bool                `json:"executionSuccessful"`
+	ToolExecutionNotifications []sarifNotification `json:"toolExecutionNotifications,omitempty"`
+}
+
+type sarifNotification struct {
+	Level   string       `json:"level"`
+	Message sarifMessage `json:"message"`
+}
+
+type sarifResult struct {
+	RuleID     string                 `json:"ruleId"`
+	RuleIndex  int                    `json:"ruleIndex"`
+	Level      string                 `json:"level"`
+	Message    sarifMessage           `json:"message"`
+	Locations  []sarifLocation        `json:"locations"`
+	Properties map[string]interface{} `json:"properties"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation *sarifPhysicalLocation `json:"physicalLocation,omitempty"`
+	LogicalLocations []sarifLogicalLocation `json:"logicalLocations"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Region           sarifRegion           `json:"region"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type sarifRegion struct {
+	StartLine int `json:"startLine"`
+}
+
+type sarifLogicalLocation struct {
+	Name               string `json:"name"`
+	FullyQualifiedName string `json:"fullyQualifiedName,omitempty"`
+	Kind               string `json:"kind"`
+}
+
+// sarifLevel maps a rule severity to a SARIF level
+func sarifLevel(severity string) string {
+	switch severityRank(severity) {
+	case severityRank("Critical"), severityRank("High"):
+		return "error"
+	case severityRank("Medium"):
+		return "warning"
+	}
+
+	return "note"
+}
+
+// assessmentSARIF returns the results of the assessment as a SARIF 2.1.0 log, a result per non-complying entity.
+// The results are located in artifactURI when it is set, code scanning tools such as GitHub's requiring a file.
+func assessmentSARIF(resp *assessment.RunBundleResponse, artifactURI string) ([]byte, error) {
+	run := sarifRun{
+		Tool: sarifTool{Driver: sarifDriver{
+			Name:           "CloudGuard Dome9",
+			InformationURI: "https://www.checkpoint.com/cloudguard/cloud-security-posture-management/",
+			Rules:          make([]sarifRule, 0),
+		}},
+		Invocations: []sarifInvocation{{ExecutionSuccessful: !resp.HasErrors}},
+		Results:     make([]sarifResult, 0),
+		Properties: map[string]interface{}{
+			"assessmentId":     resp.ID,
+			"rulesetId":        resp.Request.BundleID,
+			"cloudAccountId":   resp.Request.CloudAccountID,
+			"cloudAccountType": resp.Request.CloudAccountType,
+		},
+	}
+
+	ruleIndexes := make(map[string]int)
+	for _, test := range resp.Tests {
+		rule := test.Rule
+		ruleID := assessmentRuleID(rule)
+		index, ok := ruleIndexes[ruleID]
+		if !ok {
+			index = len(run.Tool.Driver.Rules)
+			ruleIndexes[ruleID] = index
+			run.Tool.Driver.Rules = append(run.Tool.Driver.Rules, sarifAssessmentRule(ruleID, rule))
+		}
+
+		if test.Error != "" {
+			run.Invocations[0].ToolExecutionNotifications = append(run.Invocations[0].ToolExecutionNotifications, sarifNotification{
+				Level:   "error",
+				Message: sarifMessage{Text: fmt.Sprintf("%s: %s", rule.Name, test.Error)},
+			})
+		}
+
+		for _, entity := range nonComplyingEntities(test) {
+			location := sarifLocation{LogicalLocations: []sarifLogicalLocation{{
+				Name:               assessmentEntityName(entity),
+				FullyQualifiedName: entity.Dome9Id,
+				Kind:               "resource",
+			}}}
+			if artifactURI != "" {
+				location.PhysicalLocation = &sarifPhysicalLocation{
+					ArtifactLocation: sarifArtifactLocation{URI: artifactURI},
+					Region:           sarifRegion{StartLine: 1},
+				}
+			}
+
+			run.Results = append(run.Results, sarifResult{
+				RuleID:    ruleID,
+				RuleIndex: index,
+				Level:     sarifLevel(rule.Severity),
+				Message: sarifMessage{Text: fmt.Sprintf("%s %s does not comply with %s",
+					entity.EntityType, assessmentEntityName(entity), rule.Name)},
+				Locations: []sarifLocation{location},
+				Properties: map[string]interface{}{
+					"entityType": entity.EntityType,
+					"entityId":   entity.Id,
+					"dome9Id":    entity.Dome9Id,
+				},
+			})
+		}
+	}
+
+	return json.MarshalIndent(sarifLog{
+		Schema:  "https://json.schemastore.org/sarif-2.1.0.json",
+		Version: "2.1.0",
+		Runs:    []sarifRun{run},
+	}, "", "  ")
+}
+
+func sarifAssessmentRule(ruleID string, rule assessment.Rule) sarifRule {
+	sarif := sarifRule{
+		ID:                   ruleID,
+		Name:                 rule.Name,
+		ShortDescription:     sarifMessage{Text: rule.Name},
+		DefaultConfiguration: sarifConfiguration{Level: sarifLevel(rule.Severity)},
+		Properties: map[string]interface{}{
+			"severity": rule.Severity,
+			"tags":     []string{"security", "cloudguard"},
+		},
+	}
+	if rule.Description != "" {
+		sarif.FullDescription = &sarifMessage{Text: rule.Description}
+	}
+	if rule.Remediation != "" {
+		sarif.Help = &sarifMessage{Text: rule.Remediation}
+	}
+	if score, ok := sarifSecuritySeverities[rule.Severity]; ok {
+		sarif.Properties["security-severity"] = score
+	}
+	if rule.ComplianceTag != "" {
+		sarif.Properties["complianceTag"] = rule.ComplianceTag
+	}
+	if rule.Logic != "" {
+		sarif.Properties["logic"] = rule.Logic
+	}
+
+	return sarif
+}
+
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Name     string           `xml:"name,attr"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Errors   int              `xml:"errors,attr"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name      string          `xml:"name,attr"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	Errors    int             `xml:"errors,attr"`
+	Timestamp string          `xml:"timestamp,attr,omitempty"`
+	Cases     []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Failure   *junitMessage `xml:"failure,omitempty"`
+	Error     *junitMessage `xml:"error,omitempty"`
+}
+
+type junitMessage struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// assessmentJUnit returns the results of the assessment as a JUnit XML report, a test case per rule whose failure
+// lists the non-complying entities and the remediation of the rule
+func assessmentJUnit(resp *assessment.RunBundleResponse) ([]byte, error) {
+	name := resp.Request.Name
+	if name == "" {
+		name = fmt.Sprintf("ruleset %d", resp.Request.BundleID)
+	}
+	suite := junitTestSuite{
+		Name:      fmt.Sprintf("%s on %s", name, resp.Request.CloudAccountID),
+		Tests:     len(resp.Tests),
+		Timestamp: resp.CreatedTime,
+		Cases:     make([]junitTestCase, 0, len(resp.Tests)),
+	}
+
+	for _, test := range resp.Tests {
+		testCase := junitTestCase{
+			Name:      test.Rule.Name,
+			ClassName: assessmentRuleID(test.Rule),
+		}
+
+		switch {
+		case test.Error != "":
+			suite.Errors++
+			testCase.Error = &junitMessage{Message: test.Error, Type: test.Rule.Severity}
+		case !test.TestPassed:
+			suite.Failures++
+			var text strings.Builder
+			for _, entity := range nonComplyingEntities(test) {
+				fmt.Fprintf(&text, "%s %s\n", entity.EntityType, assessmentEntityName(entity))
+			}
+			if test.Rule.Remediation != "" {
+				fmt.Fprintf(&text, "\nRemediation: %s\n", test.Rule.Remediation)
+			}
+			testCase.Failure = &junitMessage{
+				Message: fmt.Sprintf("%d non-complying of %d relevant entities", test.NonComplyingCount, test.RelevantCount),
+				Type:    test.Rule.Severity,
+				Text:    text.String(),
+			}
+		}
+		suite.Cases = append(suite.Cases, testCase)
+	}
+
+	content, err := xml.MarshalIndent(junitTestSuites{
+		Name:     fmt.Sprintf("CloudGuard assessment %d", resp.ID),
+		Tests:    suite.Tests,
+		Failures: suite.Failures,
+		Errors:   suite.Errors,
+		Suites:   []junitTestSuite{suite},
+	}, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]byte(xml.Header), append(content, '\n')...), nil
+}
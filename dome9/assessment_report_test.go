@@ -0,0 +1,120 @@
+package dome9
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/dome9/dome9-sdk-go/services/assessment"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func testAssessmentResponse() *assessment.RunBundleResponse {
+	failed := failedAssessmentTest("D9.T.2", "High", "i-2", "i-3")
+	failed.Rule.Remediation = "Remove the public IP"
+
+	return &assessment.RunBundleResponse{
+		ID:          7,
+		CreatedTime: "2024-01-01T00:00:00Z",
+		HasErrors:   true,
+		Request:     assessment.Request{BundleID: 42, Name: "CIS", CloudAccountID: "123456789012"},
+		Tests: []assessment.Test{
+			{Rule: assessment.Rule{Name: "passing", RuleID: "D9.T.1", Severity: "Low"}, TestPassed: true},
+			failed,
+			{Rule: assessment.Rule{Name: "erroring", LogicHash: "abc", Severity: "Medium"}, Error: "timeout"},
+		},
+	}
+}
+
+func TestAssessmentSARIF(t *testing.T) {
+	content, err := assessmentSARIF(testAssessmentResponse(), "main.tf")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var log sarifLog
+	if err := json.Unmarshal(content, &log); err != nil {
+		t.Fatal(err)
+	}
+	if log.Version != "2.1.0" || len(log.Runs) != 1 {
+		t.Fatalf("got version %s and %d runs", log.Version, len(log.Runs))
+	}
+	run := log.Runs[0]
+
+	rules := run.Tool.Driver.Rules
+	if len(rules) != 3 || rules[1].ID != "D9.T.2" || rules[2].ID != "abc" {
+		t.Fatalf("got rules %+v", rules)
+	}
+	if rules[1].Help == nil || rules[1].Help.Text != "Remove the public IP" || rules[1].Properties["security-severity"] != "8.0" {
+		t.Errorf("got rule %+v", rules[1])
+	}
+
+	if len(run.Results) != 2 {
+		t.Fatalf("got %d results, want one per non-complying entity", len(run.Results))
+	}
+	result := run.Results[0]
+	if result.RuleID != "D9.T.2" || result.RuleIndex != 1 || result.Level != "error" ||
+		result.Message.Text != "Instance i-2 does not comply with rule D9.T.2" {
+		t.Errorf("got result %+v", result)
+	}
+	if location := result.Locations[0]; location.PhysicalLocation == nil || location.PhysicalLocation.ArtifactLocation.URI != "main.tf" ||
+		location.LogicalLocations[0].Name != "i-2" {
+		t.Errorf("got location %+v", location)
+	}
+
+	if invocation := run.Invocations[0]; invocation.ExecutionSuccessful || len(invocation.ToolExecutionNotifications) != 1 {
+		t.Errorf("got invocation %+v", invocation)
+	}
+}
+
+func TestAssessmentJUnit(t *testing.T) {
+	content, err := assessmentJUnit(testAssessmentResponse())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var suites junitTestSuites
+	if err := xml.Unmarshal(content, &suites); err != nil {
+		t.Fatal(err)
+	}
+	if suites.Tests != 3 || suites.Failures != 1 || suites.Errors != 1 || len(suites.Suites) != 1 {
+		t.Fatalf("got %+v", suites)
+	}
+
+	cases := suites.Suites[0].Cases
+	if cases[0].Failure != nil || cases[0].Error != nil {
+		t.Errorf("passing test reported as failed: %+v", cases[0])
+	}
+	failure := cases[1].Failure
+	if failure == nil || failure.Type != "High" || failure.Message != "2 non-complying of 3 relevant entities" ||
+		!strings.Contains(failure.Text, "Instance i-3\n") || !strings.Contains(failure.Text, "Remediation: Remove the public IP") {
+		t.Errorf("got failure %+v", failure)
+	}
+	if cases[2].Error == nil || cases[2].Error.Message != "timeout" || cases[2].ClassName != "abc" {
+		t.Errorf("got %+v", cases[2])
+	}
+}
+
+func TestWriteAssessmentReports(t *testing.T) {
+	dir := t.TempDir()
+	d := schema.TestResourceDataRaw(t, resourceAssessment().Schema, map[string]interface{}{
+		"report": []interface{}{
+			map[string]interface{}{"format": "sarif", "path": filepath.Join(dir, "reports", "cloudguard.sarif")},
+			map[string]interface{}{"format": "junit", "path": filepath.Join(dir, "cloudguard.xml")},
+		},
+	})
+
+	if err := writeAssessmentReports(d, testAssessmentResponse()); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, name := range []string{filepath.Join("reports", "cloudguard.sarif"), "cloudguard.xml"} {
+		content, err := ioutil.ReadFile(filepath.Join(dir, name))
+		if err != nil || !strings.Contains(string(content), "D9.T.2") {
+			t.Errorf("report %s: got %v", name, err)
+		}
+	}
+}
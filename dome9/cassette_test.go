@@ -0,0 +1,501 @@
+package dome9
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/dome9/dome9-sdk-go/services/iplist"
+
+	"github.com/terraform-providers/terraform-provider-dome9/dome9/common/providerconst"
+	"github.com/terraform-providers/terraform-provider-dome9/dome9/common/testing/environmentvariable"
+)
+
+// Values of environmentvariable.CassetteMode
+const (
+	cassetteModeRecord = "record"
+	cassetteModeReplay = "replay"
+)
+
+const cassettesDir = "testdata/cassettes"
+
+// cassetteEnvVariables are the variables read by the acceptance tests. Their values are replaced by
+// cassettePlaceholder in the recorded interactions, and set to it when the cassettes are replayed.
+var cassetteEnvVariables = []string{
+	providerconst.ProviderAccessIDEnvVariable,
+	providerconst.ProviderSecretKeyEnvVariable,
+	environmentvariable.OrganizationalUnitName,
+	environmentvariable.CloudAccountAlibabaEnvVarAccessKey,
+	environmentvariable.CloudAccountAlibabaEnvVarAccessSecret,
+	environmentvariable.CloudAccountOciEnvVarTenancyId,
+	environmentvariable.CloudAccountOciEnvVarHomeRegion,
+	environmentvariable.CloudAccountOciEnvVarUserOcid,
+	environmentvariable.CloudAccountAWSEnvVarArn,
+	environmentvariable.CloudAccountUpdatedAWSEnvVarArn,
+	environmentvariable.CloudAccountAWSEnvVarSecret,
+	environmentvariable.AwpAwsCrossAccountRoleExternalIdEnvVar,
+	environmentvariable.CloudAccountAzureEnvVarClientId,
+	environmentvariable.CloudAccountAzureEnvVarSubscriptionId,
+	environmentvariable.CloudAccountAzureEnvVarClientPassword,
+	environmentvariable.CloudAccountAzureEnvVarTenantId,
+	environmentvariable.CloudAccountGCPEnvVarProjectId,
+	environmentvariable.CloudAccountGCPEnvVarPrivateKeyId,
+	environmentvariable.CloudAccountGCPEnvVarPrivateKey,
+	environmentvariable.CloudAccountGCPEnvVarClientEmail,
+	environmentvariable.CloudAccountGCPEnvVarClientX509CertUrl,
+	environmentvariable.AzureSecurityGroupResourceGroup,
+	environmentvariable.AttachIAMSafeEnvVarGroupArn,
+	environmentvariable.AttachIAMSafeEnvVarPolicyArn,
+	environmentvariable.AwsOrganizationOnboardingEnvVarRoleArn,
+	environmentvariable.AwsOrganizationOnboardingEnvVarSecret,
+	environmentvariable.AwsOrganizationOnboardingEnvVarStackSetArn,
+	environmentvariable.CloudAccountOrgAzureEnvVarManagementGroupId,
+	environmentvariable.CloudAccountOrgAzureEnvVarTenantId,
+}
+
+// cassettePlaceholder is the value standing for the environment variable name in the cassettes
+func cassettePlaceholder(name string) string {
+	if strings.HasSuffix(name, "ARN") || strings.Contains(name, "_ARN_") {
+		return "arn:aws:iam::000000000000:role/redacted-" + strings.ToLower(name)
+	}
+
+	return "redacted-" + strings.ToLower(name)
+}
+
+var (
+	// cassetteARN matches AWS ARNs, the account number and the resource are redacted
+	cassetteARN = regexp.MustCompile(`arn:(aws[a-z-]*):([a-z0-9-]*):([a-z0-9-]*):\d{12}:[^"\s,]+`)
+	// cassetteAccountNumber matches the values of AWS account numbers
+	cassetteAccountNumber = regexp.MustCompile(`^\d{12}$`)
+	// cassetteAccountNumberField matches the JSON fields holding AWS account numbers, e.g. externalAccountNumber
+	cassetteAccountNumberField = regexp.MustCompile(`(?i)account_?(number|id)$`)
+	// cassetteSecretField matches the JSON fields holding credentials, their values are redacted
+	cassetteSecretField = regexp.MustCompile(`(?i)(secret|password|private_?key|api_?key|access_?key|token)`)
+)
+
+// cassetteScrubber redacts the credentials, AWS account numbers and ARNs of the recorded interactions
+type cassetteScrubber struct {
+	// values maps the values of the environment variables to their placeholder, longest first
+	values [][2]string
+	// accountNumbers are the AWS account numbers seen in the account number fields and the ARNs, they are
+	// redacted wherever they appear, e.g. in the URLs
+	accountNumbers map[string]bool
+}
+
+func newCassetteScrubber(getenv func(string) string) *cassetteScrubber {
+	s := &cassetteScrubber{accountNumbers: make(map[string]bool)}
+	for _, name := range cassetteEnvVariables {
+		// short values such as a region would redact unrelated parts of the bodies
+		if value := getenv(name); len(value) >= 4 {
+			s.values = append(s.values, [2]string{value, cassettePlaceholder(name)})
+			s.addAccountNumbers(value)
+		}
+	}
+	sort.SliceStable(s.values, func(i, j int) bool {
+		return len(s.values[i][0]) > len(s.values[j][0])
+	})
+
+	return s
+}
+
+// addAccountNumbers keeps the account numbers of the ARNs of value
+func (s *cassetteScrubber) addAccountNumbers(value string) {
+	for _, arn := range cassetteARN.FindAllString(value, -1) {
+		s.accountNumbers[strings.Split(arn, ":")[4]] = true
+	}
+}
+
+func (s *cassetteScrubber) scrubString(value string) string {
+	for _, v := range s.values {
+		value = strings.ReplaceAll(value, v[0], v[1])
+	}
+	s.addAccountNumbers(value)
+	value = cassetteARN.ReplaceAllStringFunc(value, func(arn string) string {
+		// placeholders and ARNs redacted already are kept
+		if strings.Contains(arn, ":000000000000:") {
+			return arn
+		}
+		return cassetteARN.ReplaceAllString(arn, "arn:$1:$2:$3:000000000000:redacted")
+	})
+	for accountNumber := range s.accountNumbers {
+		value = strings.ReplaceAll(value, accountNumber, "000000000000")
+	}
+
+	return value
+}
+
+// scrubBody redacts a request or response body, the values of the credentials fields of JSON bodies are dropped
+func (s *cassetteScrubber) scrubBody(body []byte) string {
+	var v interface{}
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	if len(body) == 0 || decoder.Decode(&v) != nil {
+		return s.scrubString(string(body))
+	}
+
+	s.collectAccountNumbers(v)
+	scrubbed, err := json.Marshal(s.scrubJSON(v))
+	if err != nil {
+		return s.scrubString(string(body))
+	}
+
+	return string(scrubbed)
+}
+
+// collectAccountNumbers keeps the values of the account number fields of v, before any field is scrubbed
+func (s *cassetteScrubber) collectAccountNumbers(v interface{}) {
+	switch value := v.(type) {
+	case map[string]interface{}:
+		for key, field := range value {
+			if accountNumber := fmt.Sprint(field); cassetteAccountNumberField.MatchString(key) && cassetteAccountNumber.MatchString(accountNumber) {
+				s.accountNumbers[accountNumber] = true
+			}
+			s.collectAccountNumbers(field)
+		}
+	case []interface{}:
+		for _, item := range value {
+			s.collectAccountNumbers(item)
+		}
+	}
+}
+
+func (s *cassetteScrubber) scrubJSON(v interface{}) interface{} {
+	switch value := v.(type) {
+	case map[string]interface{}:
+		for key, field := range value {
+			if _, isString := field.(string); isString && cassetteSecretField.MatchString(key) && field != "" {
+				value[key] = "REDACTED"
+				continue
+			}
+			value[key] = s.scrubJSON(field)
+		}
+	case []interface{}:
+		for i := range value {
+			value[i] = s.scrubJSON(value[i])
+		}
+	case string:
+		return s.scrubString(value)
+	case json.Number:
+		return json.Number(s.scrubString(value.String()))
+	}
+
+	return v
+}
+
+type cassetteInteraction struct {
+	Request struct {
+		Method string `json:"method"`
+		// URL is the path and query of the request, without the API host
+		URL  string `json:"url"`
+		Body string `json:"body,omitempty"`
+	} `json:"request"`
+	Response struct {
+		StatusCode  int    `json:"status_code"`
+		ContentType string `json:"content_type,omitempty"`
+		Body        string `json:"body,omitempty"`
+	} `json:"response"`
+}
+
+type cassette struct {
+	Interactions []*cassetteInteraction `json:"interactions"`
+}
+
+// cassetteRecorder is a transport for dome9.Config.HTTPClient. In record mode it sends the requests to the API
+// and keeps the scrubbed interactions, in replay mode it answers the requests from the interactions of the
+// cassette file, in the order they were recorded, without any network access.
+type cassetteRecorder struct {
+	mode      string
+	path      string
+	transport http.RoundTripper
+	scrubber  *cassetteScrubber
+
+	mu       sync.Mutex
+	cassette cassette
+	replayed []bool
+}
+
+func newCassetteRecorder(mode, path string, transport http.RoundTripper) (*cassetteRecorder, error) {
+	r := &cassetteRecorder{mode: mode, path: path, transport: transport, scrubber: newCassetteScrubber(os.Getenv)}
+	if mode != cassetteModeReplay {
+		return r, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &r.cassette); err != nil {
+		return nil, fmt.Errorf("failed to decode cassette %s: %w", path, err)
+	}
+	r.replayed = make([]bool, len(r.cassette.Interactions))
+
+	return r, nil
+}
+
+func (r *cassetteRecorder) client() *http.Client {
+	return &http.Client{Transport: r}
+}
+
+func (r *cassetteRecorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	var reqBody []byte
+	if req.Body != nil {
+		var err error
+		if reqBody, err = io.ReadAll(req.Body); err != nil {
+			return nil, err
+		}
+		_ = req.Body.Close()
+		req.Body = io.NopCloser(bytes.NewReader(reqBody))
+	}
+	// the body is scrubbed first, the account numbers it holds are then redacted from the URL as well
+	scrubbedReqBody := r.scrubber.scrubBody(reqBody)
+	url := r.scrubber.scrubString(req.URL.RequestURI())
+
+	if r.mode == cassetteModeReplay {
+		return r.replay(req, url)
+	}
+
+	resp, err := r.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	interaction := &cassetteInteraction{}
+	interaction.Request.Method = req.Method
+	interaction.Request.URL = url
+	interaction.Request.Body = scrubbedReqBody
+	interaction.Response.StatusCode = resp.StatusCode
+	interaction.Response.ContentType = resp.Header.Get("Content-Type")
+	interaction.Response.Body = r.scrubber.scrubBody(respBody)
+
+	r.mu.Lock()
+	r.cassette.Interactions = append(r.cassette.Interactions, interaction)
+	r.mu.Unlock()
+
+	return resp, nil
+}
+
+// replay answers with the first interaction not replayed yet for the method and URL of the request. Calls
+// missing from the cassette get a 501 response: the SDK panics on transport errors.
+func (r *cassetteRecorder) replay(req *http.Request, url string) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i, interaction := range r.cassette.Interactions {
+		if r.replayed[i] || interaction.Request.Method != req.Method || interaction.Request.URL != url {
+			continue
+		}
+		r.replayed[i] = true
+
+		return cassetteResponse(req, interaction.Response.StatusCode, interaction.Response.ContentType, interaction.Response.Body), nil
+	}
+
+	message, _ := json.Marshal(map[string]string{
+		"message": fmt.Sprintf("cassette %s has no interaction left for %s %s, record it again", r.path, req.Method, url),
+	})
+	return cassetteResponse(req, http.StatusNotImplemented, "application/json", string(message)), nil
+}
+
+func cassetteResponse(req *http.Request, status int, contentType, body string) *http.Response {
+	header := make(http.Header)
+	if contentType != "" {
+		header.Set("Content-Type", contentType)
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", status, http.StatusText(status)),
+		StatusCode:    status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(strings.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
+
+// save writes the recorded interactions to the cassette file
+func (r *cassetteRecorder) save() error {
+	if r.mode != cassetteModeRecord {
+		return nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	data, err := json.MarshalIndent(r.cassette, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(r.path), 0755); err != nil {
+		return err
+	}
+
+	return os.WriteFile(r.path, append(data, '\n'), 0644)
+}
+
+// useCassette records the API calls of the acceptance test t, or replays them, according to environmentvariable.CassetteMode.
+// The test gets its own testAccProvider using the recorder until it ends. The test is skipped when there is no
+// cassette to replay.
+func useCassette(t *testing.T) {
+	t.Helper()
+	mode := os.Getenv(environmentvariable.CassetteMode)
+	if mode == "" {
+		return
+	}
+	if mode != cassetteModeRecord && mode != cassetteModeReplay {
+		t.Fatalf("%s must be %q or %q, got %q", environmentvariable.CassetteMode, cassetteModeRecord, cassetteModeReplay, mode)
+	}
+
+	path := filepath.Join(cassettesDir, strings.ReplaceAll(t.Name(), "/", "_")+".json")
+	recorder, err := newCassetteRecorder(mode, path, http.DefaultTransport)
+	if os.IsNotExist(err) {
+		t.Skipf("no cassette to replay at %s", path)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// t.Setenv fails the parallel tests, which would share testAccProvider
+	t.Setenv(environmentvariable.CassetteMode, mode)
+	previous := testAccProvider
+	testAccProvider = newTestAccProvider(recorder.client())
+	testAccProviders["dome9"] = testAccProvider
+	t.Cleanup(func() {
+		testAccProvider = previous
+		testAccProviders["dome9"] = previous
+		// a failed run may have stopped halfway, keep the previous cassette
+		if t.Failed() {
+			return
+		}
+		if err := recorder.save(); err != nil {
+			t.Errorf("failed to save cassette %s: %v", path, err)
+		}
+	})
+}
+
+// setCassetteEnv makes the acceptance tests run offline from the cassettes in replay mode. The credentials and
+// the variables the tests read are set to the placeholders of the cassettes, the configurations then match the
+// recorded requests.
+func setCassetteEnv() {
+	if os.Getenv(environmentvariable.CassetteMode) != cassetteModeReplay {
+		return
+	}
+
+	_ = os.Setenv("TF_ACC", "1")
+	for _, name := range cassetteEnvVariables {
+		_ = os.Setenv(name, cassettePlaceholder(name))
+	}
+}
+
+func TestCassetteScrubber(t *testing.T) {
+	env := map[string]string{
+		providerconst.ProviderSecretKeyEnvVariable:   "top-secret-key",
+		environmentvariable.CloudAccountAWSEnvVarArn: "arn:aws:iam::123456789012:role/dome9-connect",
+	}
+	s := newCassetteScrubber(func(name string) string { return env[name] })
+
+	got := s.scrubBody([]byte(`{"name":"account","credentials":{"arn":"arn:aws:iam::123456789012:role/dome9-connect","secret":"abc","type":"RoleBased"},` +
+		`"externalAccountNumber":"210987654321","stackArn":"arn:aws-us-gov:cloudformation:us-gov-west-1:210987654321:stack/onboarding/1","note":"top-secret-key","id":12}`))
+	want := `{"credentials":{"arn":"arn:aws:iam::000000000000:role/redacted-arn","secret":"REDACTED","type":"RoleBased"},` +
+		`"externalAccountNumber":"000000000000","id":12,"name":"account","note":"redacted-dome9_secret_key","stackArn":"arn:aws-us-gov:cloudformation:us-gov-west-1:000000000000:redacted"}`
+	if got != want {
+		t.Errorf("got scrubbed body\n%s\nwant\n%s", got, want)
+	}
+
+	if got := s.scrubString("/v2/CloudAccounts/210987654321?region=us-east-1"); got != "/v2/CloudAccounts/000000000000?region=us-east-1" {
+		t.Errorf("got scrubbed URL %s", got)
+	}
+	if got := s.scrubBody([]byte("not json 123456789012")); got != "not json 000000000000" {
+		t.Errorf("got scrubbed text %s", got)
+	}
+
+	// other numbers of 12 digits, such as IDs and timestamps, are kept
+	got = s.scrubBody([]byte(`{"id":345678901234,"lastSeen":"163456789012","accountId":12345}`))
+	if want := `{"accountId":12345,"id":345678901234,"lastSeen":"163456789012"}`; got != want {
+		t.Errorf("got scrubbed body\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestCassetteRecordReplay(t *testing.T) {
+	t.Setenv(providerconst.ProviderSecretKeyEnvVariable, "top-secret-key")
+	api := newFakeDome9API(t)
+	path := filepath.Join(t.TempDir(), "cassette.json")
+
+	recorder, err := newCassetteRecorder(cassetteModeRecord, path, http.DefaultTransport)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client, err := (&Config{AccessID: "access-id", SecretKey: "top-secret-key", BaseURL: api.server.URL + "/v2/", HTTPClient: recorder.client()}).Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+	created, _, err := client.iplist.Create(&iplist.IpList{Name: "office", Items: []iplist.Item{{Ip: "10.0.0.0/8"}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := client.iplist.Get(created.Id); err != nil {
+		t.Fatal(err)
+	}
+	if err := recorder.save(); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(data), "top-secret-key") || strings.Contains(string(data), "access-id") {
+		t.Errorf("cassette contains the credentials:\n%s", data)
+	}
+
+	// the API is gone, the calls are answered from the cassette
+	api.server.Close()
+	replayer, err := newCassetteRecorder(cassetteModeReplay, path, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client, err = (&Config{AccessID: "access-id", SecretKey: "secret", BaseURL: "https://api.dome9.com/v2/", HTTPClient: replayer.client()}).Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+	replayed, _, err := client.iplist.Create(&iplist.IpList{Name: "office", Items: []iplist.Item{{Ip: "10.0.0.0/8"}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, _, err := client.iplist.Get(replayed.Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Id != created.Id || got.Name != "office" {
+		t.Errorf("got replayed ip list %+v, want %+v", got, created)
+	}
+
+	req, err := http.NewRequest(http.MethodGet, "https://api.dome9.com/v2/iplist/1", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := replayer.RoundTrip(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	if resp.StatusCode != http.StatusNotImplemented || !strings.Contains(string(body), "no interaction left for GET /v2/iplist/1") {
+		t.Errorf("got %d %s for a call missing from the cassette", resp.StatusCode, body)
+	}
+}
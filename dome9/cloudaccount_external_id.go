@@ -0,0 +1,81 @@
+package dome9
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// cloudAccountLookup finds the cloud accounts of a vendor by their identifier in the cloud, written
+// <prefix>:<identifier> such as aws:123456789012
+type cloudAccountLookup struct {
+	prefix string
+	// externalIDName names the identifier in the errors
+	externalIDName string
+	list           cloudAccountLister
+}
+
+var (
+	awsCloudAccountLookup     = cloudAccountLookup{prefix: "aws", externalIDName: "account number", list: listAWSCloudAccounts}
+	azureCloudAccountLookup   = cloudAccountLookup{prefix: "azure", externalIDName: "subscription ID", list: listAzureCloudAccounts}
+	gcpCloudAccountLookup     = cloudAccountLookup{prefix: "gcp", externalIDName: "project ID", list: listGCPCloudAccounts}
+	alibabaCloudAccountLookup = cloudAccountLookup{prefix: "alibaba", externalIDName: "account ID", list: listAlibabaCloudAccounts}
+	ociCloudAccountLookup     = cloudAccountLookup{prefix: "oci", externalIDName: "tenancy OCID", list: listOCICloudAccounts}
+)
+
+// cloudAccountLookups are the lookups of all the vendors, to report the identifiers of another vendor
+var cloudAccountLookups = []cloudAccountLookup{awsCloudAccountLookup, azureCloudAccountLookup, gcpCloudAccountLookup,
+	alibabaCloudAccountLookup, ociCloudAccountLookup}
+
+// resolve returns the Dome9 ID of the cloud account of id, which is either the Dome9 ID or the identifier of the
+// account in the cloud prefixed by the vendor
+func (l cloudAccountLookup) resolve(d9Client *Client, id string) (string, error) {
+	externalID := strings.TrimPrefix(id, l.prefix+":")
+	if externalID == id {
+		for _, other := range cloudAccountLookups {
+			if strings.HasPrefix(id, other.prefix+":") {
+				return "", fmt.Errorf("%q refers to the %s vendor, expected the Dome9 ID or %s:<%s>", id, other.prefix, l.prefix, l.externalIDName)
+			}
+		}
+		return id, nil
+	}
+
+	accounts, err := l.list(d9Client)
+	if err != nil {
+		return "", fmt.Errorf("failed listing the %s cloud accounts: %w", l.prefix, err)
+	}
+
+	var ids []string
+	for _, account := range accounts {
+		if strings.EqualFold(account.externalID, externalID) {
+			ids = append(ids, account.id)
+		}
+	}
+	switch len(ids) {
+	case 0:
+		return "", fmt.Errorf("no %s cloud account with %s %s is onboarded to Dome9", l.prefix, l.externalIDName, externalID)
+	case 1:
+		log.Printf("[INFO] Resolved %s to %s cloud account %s\n", id, l.prefix, ids[0])
+		return ids[0], nil
+	}
+
+	return "", fmt.Errorf("%d %s cloud accounts have %s %s, use the Dome9 ID of one of %s", len(ids), l.prefix, l.externalIDName,
+		externalID, strings.Join(ids, ", "))
+}
+
+// importer returns an importer accepting the Dome9 ID or the identifier of the account in the cloud
+func (l cloudAccountLookup) importer() *schema.ResourceImporter {
+	return &schema.ResourceImporter{
+		State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			id, err := l.resolve(meta.(*Client), d.Id())
+			if err != nil {
+				return nil, err
+			}
+			d.SetId(id)
+
+			return []*schema.ResourceData{d}, nil
+		},
+	}
+}
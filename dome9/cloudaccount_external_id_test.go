@@ -0,0 +1,74 @@
+package dome9
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestResourceCloudAccountAWSImportByAccountNumber(t *testing.T) {
+	api := newFakeDome9API(t)
+	config := getCloudAccountAWSDeletionHCL(false, true)
+	importStep := api.importStep(config, "dome9_cloudaccount_aws.test", "credentials")
+	importStep.ImportStateId = "aws:123456789012"
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    api.providers(),
+		CheckDestroy: api.checkDestroyed("cloudaccounts"),
+		Steps: []resource.TestStep{
+			{
+				Config: api.config(config),
+			},
+			importStep,
+			{
+				Config:        api.config(config),
+				ResourceName:  "dome9_cloudaccount_aws.test",
+				ImportState:   true,
+				ImportStateId: "aws:210987654321",
+				ExpectError:   regexp.MustCompile(`no aws cloud account with account number 210987654321 is onboarded to Dome9`),
+			},
+			{
+				Config:        api.config(config),
+				ResourceName:  "dome9_cloudaccount_aws.test",
+				ImportState:   true,
+				ImportStateId: "azure:22222222-2222-2222-2222-222222222222",
+				ExpectError:   regexp.MustCompile(`refers to the azure vendor, expected the Dome9 ID or aws:<account number>`),
+			},
+		},
+	})
+}
+
+func TestDataSourceCloudAccountAzureBySubscriptionID(t *testing.T) {
+	api := newFakeDome9API(t)
+	subscriptionID := "22222222-2222-2222-2222-222222222222"
+	config := fmt.Sprintf(`
+resource "dome9_cloudaccount_azure" "test" {
+  name            = "external-id"
+  operation_mode  = "Read"
+  subscription_id = "%s"
+  tenant_id       = "33333333-3333-3333-3333-333333333333"
+  client_id       = "11111111-1111-1111-1111-111111111111"
+  client_password = "password"
+}
+
+data "dome9_cloudaccount_azure" "test" {
+  id = "azure:${dome9_cloudaccount_azure.test.subscription_id}"
+}
+`, subscriptionID)
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    api.providers(),
+		CheckDestroy: api.checkDestroyed("AzureCloudAccount"),
+		Steps: []resource.TestStep{
+			{
+				Config: api.config(config),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.dome9_cloudaccount_azure.test", "id", "dome9_cloudaccount_azure.test", "id"),
+					resource.TestCheckResourceAttr("data.dome9_cloudaccount_azure.test", "subscription_id", subscriptionID),
+				),
+			},
+		},
+	})
+}
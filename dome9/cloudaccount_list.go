@@ -0,0 +1,72 @@
+package dome9
+
+// cloudAccountSummary is the Dome9 ID of a cloud account, its name and its identifier in the cloud
+type cloudAccountSummary struct {
+	id         string
+	name       string
+	externalID string
+}
+
+// cloudAccountLister lists the cloud accounts of a vendor, shared by the export and the lookup by identifier in the
+// cloud
+type cloudAccountLister func(d9Client *Client) ([]cloudAccountSummary, error)
+
+func listAWSCloudAccounts(d9Client *Client) ([]cloudAccountSummary, error) {
+	resp, _, err := d9Client.cloudaccountAWS.GetAll()
+	if err != nil {
+		return nil, err
+	}
+	var accounts []cloudAccountSummary
+	for _, account := range *resp {
+		accounts = append(accounts, cloudAccountSummary{account.ID, account.Name, account.ExternalAccountNumber})
+	}
+	return accounts, nil
+}
+
+func listAzureCloudAccounts(d9Client *Client) ([]cloudAccountSummary, error) {
+	resp, _, err := d9Client.cloudaccountAzure.GetAll()
+	if err != nil {
+		return nil, err
+	}
+	var accounts []cloudAccountSummary
+	for _, account := range *resp {
+		accounts = append(accounts, cloudAccountSummary{account.ID, account.Name, account.SubscriptionID})
+	}
+	return accounts, nil
+}
+
+func listGCPCloudAccounts(d9Client *Client) ([]cloudAccountSummary, error) {
+	resp, _, err := d9Client.cloudaccountGCP.GetAll()
+	if err != nil {
+		return nil, err
+	}
+	var accounts []cloudAccountSummary
+	for _, account := range *resp {
+		accounts = append(accounts, cloudAccountSummary{account.ID, account.Name, account.ProjectID})
+	}
+	return accounts, nil
+}
+
+func listAlibabaCloudAccounts(d9Client *Client) ([]cloudAccountSummary, error) {
+	resp, _, err := d9Client.cloudaccountAlibaba.GetAll()
+	if err != nil {
+		return nil, err
+	}
+	var accounts []cloudAccountSummary
+	for _, account := range *resp {
+		accounts = append(accounts, cloudAccountSummary{account.ID, account.Name, account.AlibabaAccountId})
+	}
+	return accounts, nil
+}
+
+func listOCICloudAccounts(d9Client *Client) ([]cloudAccountSummary, error) {
+	resp, _, err := d9Client.cloudaccountOci.GetAll()
+	if err != nil {
+		return nil, err
+	}
+	var accounts []cloudAccountSummary
+	for _, account := range *resp {
+		accounts = append(accounts, cloudAccountSummary{account.ID, account.Name, account.TenancyId})
+	}
+	return accounts, nil
+}
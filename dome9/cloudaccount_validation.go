@@ -0,0 +1,73 @@
+package dome9
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// unknownValuePlaceholder stands for the values known after apply in the checks requiring a value
+const unknownValuePlaceholder = "(known after apply)"
+
+var (
+	uuidPattern           = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+	pemPrivateKeyPattern  = regexp.MustCompile(`-----BEGIN (RSA )?PRIVATE KEY-----`)
+	serviceAccountPattern = regexp.MustCompile(`^[^@\s]+@[^@\s]+\.gserviceaccount\.com$`)
+	httpsURLPattern       = regexp.MustCompile(`^https://\S+$`)
+	nonEmptyPattern       = regexp.MustCompile(`\S`)
+	ociTenancyPattern     = regexp.MustCompile(`^ocid1\.tenancy\.`)
+	ociUserPattern        = regexp.MustCompile(`^ocid1\.user\.`)
+)
+
+// plannedString returns the planned value of key, false when it is known after apply only
+func plannedString(d *schema.ResourceDiff, key string) (string, bool) {
+	if !d.NewValueKnown(key) {
+		return "", false
+	}
+	value, _ := d.Get(key).(string)
+
+	return value, true
+}
+
+// plannedStringOrPlaceholder returns the planned value of key, unknownValuePlaceholder when it is known after apply
+// only
+func plannedStringOrPlaceholder(d *schema.ResourceDiff, key string) string {
+	if value, ok := plannedString(d, key); ok {
+		return value
+	}
+
+	return unknownValuePlaceholder
+}
+
+// cloudAccountCheck is a plan-time check of an argument of a cloud account resource
+type cloudAccountCheck struct {
+	key     string
+	pattern *regexp.Regexp
+	// expected describes the values matching pattern
+	expected string
+	// sensitive keeps the value out of the error
+	sensitive bool
+}
+
+// customizeCloudAccountDiff returns a CustomizeDiff running the checks on the arguments of the new accounts and on the
+// changed arguments of the existing ones, the values known after apply being left to Dome9
+func customizeCloudAccountDiff(checks ...cloudAccountCheck) schema.CustomizeDiffFunc {
+	return func(d *schema.ResourceDiff, meta interface{}) error {
+		for _, check := range checks {
+			if d.Id() != "" && !d.HasChange(check.key) {
+				continue
+			}
+			value, ok := plannedString(d, check.key)
+			if !ok || check.pattern.MatchString(value) {
+				continue
+			}
+			if check.sensitive {
+				return fmt.Errorf("%s: expected %s", check.key, check.expected)
+			}
+			return fmt.Errorf("%s: expected %s, got %q", check.key, check.expected, value)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,156 @@
+package dome9
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func getCloudAccountAWSValidationHCL(vendor, credentials, regions string) string {
+	return fmt.Sprintf(`
+resource "dome9_cloudaccount_aws" "test" {
+  name   = "validation"
+  vendor = "%s"
+
+  credentials {
+%s
+  }
+
+  net_sec {
+%s
+  }
+}
+`, vendor, credentials, regions)
+}
+
+func TestResourceCloudAccountAWSPlanValidation(t *testing.T) {
+	api := newFakeDome9API(t)
+	userBased := `    type    = "UserBased"
+    api_key = "AKIA0000000000000000"
+    secret  = "secret"`
+	usEast := `    regions {
+      region = "us_east_1"
+    }`
+
+	cases := []struct {
+		name   string
+		config string
+		error  string
+	}{
+		{"onboarding type", getCloudAccountAWSValidationHCL("aws", userBased, usEast),
+			`credentials.0.type: aws vendor requires the RoleBased onboarding type, got "UserBased"`},
+		{"arn", getCloudAccountAWSValidationHCL("aws", `    type   = "RoleBased"
+    secret = "secret"`, usEast),
+			`credentials.0.arn: the ARN of the Dome9 role is required`},
+		{"api key", getCloudAccountAWSValidationHCL("awsgov", `    type   = "UserBased"
+    secret = "secret"`, `    regions {
+      region = "us_gov_west_1"
+    }`),
+			`credentials.0.api_key: the access key of the Dome9 user is required`},
+		{"region", getCloudAccountAWSValidationHCL("awsgov", userBased, `    regions {
+      region = "us_gov_west_1"
+    }
+    regions {
+      region = "us_east_1"
+    }`),
+			`net_sec.0.regions.1.region: awsGov vendor doesn't support region us_east_1`},
+		{"china region", getCloudAccountAWSValidationHCL("awschina", userBased, usEast),
+			`net_sec.0.regions.0.region: awsChina vendor doesn't support region us_east_1`},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				Providers: api.providers(),
+				Steps: []resource.TestStep{
+					{
+						Config:      api.config(c.config),
+						PlanOnly:    true,
+						ExpectError: regexp.MustCompile(regexp.QuoteMeta(c.error)),
+					},
+				},
+			})
+		})
+	}
+
+	// the values known after apply are left to the create time validation
+	t.Run("unknown arn", func(t *testing.T) {
+		resource.UnitTest(t, resource.TestCase{
+			Providers: api.providers(),
+			Steps: []resource.TestStep{
+				{
+					Config: api.config(`
+resource "dome9_iplist" "test" {
+  name = "validation"
+}
+` + getCloudAccountAWSValidationHCL("aws", `    type   = "RoleBased"
+    arn    = "arn:aws:iam::123456789012:role/${dome9_iplist.test.id}"
+    secret = "secret"`, usEast)),
+					PlanOnly:           true,
+					ExpectNonEmptyPlan: true,
+				},
+			},
+		})
+	})
+}
+
+func TestResourceCloudAccountsPlanValidation(t *testing.T) {
+	api := newFakeDome9API(t)
+	cases := []struct {
+		name   string
+		config string
+		error  string
+	}{
+		{"azure subscription", `
+resource "dome9_cloudaccount_azure" "test" {
+  name            = "validation"
+  operation_mode  = "Read"
+  subscription_id = "my-subscription"
+  tenant_id       = "33333333-3333-3333-3333-333333333333"
+  client_id       = "11111111-1111-1111-1111-111111111111"
+  client_password = "password"
+}
+`, `subscription_id: expected the UUID of the subscription, got "my-subscription"`},
+		{"gcp private key", `
+resource "dome9_cloudaccount_gcp" "test" {
+  name                 = "validation"
+  project_id           = "project-id"
+  private_key_id       = "private-key-id"
+  private_key          = "not a key"
+  client_email         = "dome9@project-id.iam.gserviceaccount.com"
+  client_id            = "client-id"
+  client_x509_cert_url = "https://www.googleapis.com/robot/v1/metadata/x509/dome9"
+}
+`, `private_key: expected the PEM private key of the service account key file`},
+		{"alibaba access key", `
+resource "dome9_cloudaccount_alibaba" "test" {
+  name = "validation"
+  credentials = {
+    access_key    = ""
+    access_secret = "secret"
+  }
+}
+`, `credentials.access_key: expected the AccessKey ID of the Dome9 RAM user, got ""`},
+		{"oci tenancy", `
+resource "dome9_cloudaccount_oci" "test" {
+  tenancy_id = "ocid1.user.oc1..aaaa"
+  user_ocid  = "ocid1.user.oc1..aaaa"
+}
+`, `tenancy_id: expected the OCID of the tenancy`},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				Providers: api.providers(),
+				Steps: []resource.TestStep{
+					{
+						Config:      api.config(c.config),
+						PlanOnly:    true,
+						ExpectError: regexp.MustCompile(regexp.QuoteMeta(c.error)),
+					},
+				},
+			})
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package gsl
+
+// Rule is a parsed GSL rule, "<Entity> [where <Where>] should [not] [have] <Should>". The logic expressions of the
+// exclusions and the remediations may have no should part, Should being nil.
+type Rule struct {
+	Entity string
+	// Where selects the entities the rule applies to, nil for all of them
+	Where Expr
+	// Not is set for "should not"
+	Not    bool
+	Should Expr
+}
+
+// Expr is a condition, one of *Binary, *Not and *Predicate
+type Expr interface {
+	Position() Pos
+}
+
+// Binary is "<Left> and <Right>" or "<Left> or <Right>"
+type Binary struct {
+	Pos   Pos
+	Op    string
+	Left  Expr
+	Right Expr
+}
+
+// Not is "not <Expr>"
+type Not struct {
+	Pos  Pos
+	Expr Expr
+}
+
+// Predicate is a condition on a property such as "port<=22", "tags isEmpty()", "name like 'prod%'" or
+// "inboundRules contain [scope='0.0.0.0/0']"
+type Predicate struct {
+	Pos     Pos
+	Subject Operand
+	// Calls are the functions applied to the subject, in order, e.g. length() in "policies length() > 0"
+	Calls []Call
+	// Negated is set for the operators preceded by not, e.g. "name not like 'prod%'"
+	Negated bool
+	// Operator is lower case, empty when the subject is tested on its own, e.g. "isPublic" or "tags isEmpty()"
+	Operator string
+	Args     []Operand
+	// Block is the condition of the contain operators and of with
+	Block []Expr
+}
+
+// Operand is a property path or a literal
+type Operand struct {
+	Pos  Pos
+	Kind OperandKind
+	// Value is the path, the text of the string, number or regular expression, "true", "false" or "null"
+	Value string
+	// Flags are the flags of a regular expression, e.g. "i" for /foo/i
+	Flags string
+}
+
+type OperandKind int
+
+const (
+	OperandPath OperandKind = iota
+	OperandString
+	OperandNumber
+	OperandBool
+	OperandNull
+	OperandRegex
+)
+
+// Call is a function applied to a property, such as "length()" or "after(-90, 'days')"
+type Call struct {
+	Pos  Pos
+	Name string
+	Args []Operand
+}
+
+func (e *Binary) Position() Pos    { return e.Pos }
+func (e *Not) Position() Pos       { return e.Pos }
+func (e *Predicate) Position() Pos { return e.Pos }
@@ -0,0 +1,476 @@
+package gsl
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Result is the outcome of a rule for an entity
+type Result string
+
+const (
+	Passed Result = "passed"
+	Failed Result = "failed"
+	// NotRelevant is the result of the entities the where condition of the rule doesn't select
+	NotRelevant Result = "not_relevant"
+)
+
+// Evaluate parses logic and evaluates it against the JSON of an entity, entities being assumed to be of the type of
+// the rule
+func Evaluate(logic string, entityJSON []byte) (Result, error) {
+	rule, err := ParseRule(logic)
+	if err != nil {
+		return "", err
+	}
+
+	var entity interface{}
+	if err := json.Unmarshal(entityJSON, &entity); err != nil {
+		return "", fmt.Errorf("invalid entity JSON: %w", err)
+	}
+
+	return rule.Evaluate(entity)
+}
+
+// Evaluate evaluates the rule against an entity decoded from JSON, the dates being compared with the current time
+func (r *Rule) Evaluate(entity interface{}) (Result, error) {
+	return r.EvaluateAt(entity, time.Now())
+}
+
+// EvaluateAt evaluates the rule as Evaluate, the dates being compared with now. The evaluation is local and
+// approximates the one of Dome9: the contain operators and with select the items, or the object, matching any of
+// their comma separated conditions and a bare value in a condition matches the items equal to it.
+func (r *Rule) EvaluateAt(entity interface{}, now time.Time) (Result, error) {
+	e := &evaluator{now: now}
+	if r.Where != nil {
+		relevant, err := e.eval(r.Where, entity)
+		if err != nil {
+			return "", err
+		}
+		if !relevant {
+			return NotRelevant, nil
+		}
+	}
+	if r.Should == nil {
+		return Passed, nil
+	}
+
+	ok, err := e.eval(r.Should, entity)
+	if err != nil {
+		return "", err
+	}
+	if ok != r.Not {
+		return Passed, nil
+	}
+
+	return Failed, nil
+}
+
+type evaluator struct {
+	now time.Time
+}
+
+func (e *evaluator) errorf(pos Pos, format string, args ...interface{}) error {
+	return &Error{Pos: pos, Message: fmt.Sprintf(format, args...)}
+}
+
+func (e *evaluator) eval(expr Expr, context interface{}) (bool, error) {
+	switch expr := expr.(type) {
+	case *Binary:
+		left, err := e.eval(expr.Left, context)
+		if err != nil {
+			return false, err
+		}
+		if expr.Op == "and" && !left || expr.Op == "or" && left {
+			return left, nil
+		}
+		return e.eval(expr.Right, context)
+	case *Not:
+		ok, err := e.eval(expr.Expr, context)
+		return !ok, err
+	case *Predicate:
+		ok, err := e.predicate(expr, context)
+		return ok != expr.Negated, err
+	}
+
+	return false, fmt.Errorf("unexpected expression %T", expr)
+}
+
+func (e *evaluator) predicate(p *Predicate, context interface{}) (bool, error) {
+	value := e.operand(p.Subject, context)
+	for _, call := range p.Calls {
+		var err error
+		if value, err = e.call(call, value, context); err != nil {
+			return false, err
+		}
+	}
+
+	switch p.Operator {
+	case "":
+		// a bare value in the condition of a contain operator matches the items equal to it
+		if p.Subject.Kind != OperandPath && len(p.Calls) == 0 {
+			return equal(context, value), nil
+		}
+		return truthy(value), nil
+	case "=":
+		return equal(value, e.operand(p.Args[0], context)), nil
+	case "!=":
+		return !equal(value, e.operand(p.Args[0], context)), nil
+	case "<", ">", "<=", ">=":
+		return compare(p.Operator, value, e.operand(p.Args[0], context)), nil
+	case "in":
+		for _, arg := range p.Args {
+			if equal(value, e.operand(arg, context)) {
+				return true, nil
+			}
+		}
+		return false, nil
+	case "like", "unlike":
+		ok, err := like(value, e.operand(p.Args[0], context))
+		if err != nil {
+			return false, e.errorf(p.Args[0].Pos, "%s", err)
+		}
+		return ok == (p.Operator == "like"), nil
+	case "regexmatch":
+		pattern := withRegexFlags(fmt.Sprint(e.operand(p.Args[0], context)), p.Args[0].Flags)
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return false, e.errorf(p.Args[0].Pos, "invalid regular expression: %s", err)
+		}
+		s, ok := value.(string)
+		return ok && re.MatchString(s), nil
+	}
+
+	return e.block(p, value)
+}
+
+// withRegexFlags applies the flags of a regular expression to pattern. The i, m and s flags are the ones of Go, y
+// anchors the match at the start, g and u change nothing to a match.
+func withRegexFlags(pattern, flags string) string {
+	if strings.ContainsRune(flags, 'y') {
+		pattern = "^(?:" + pattern + ")"
+	}
+
+	var goFlags string
+	for _, flag := range "ims" {
+		if strings.ContainsRune(flags, flag) {
+			goFlags += string(flag)
+		}
+	}
+	if goFlags != "" {
+		pattern = "(?" + goFlags + ")" + pattern
+	}
+
+	return pattern
+}
+
+// block evaluates the contain operators and with
+func (e *evaluator) block(p *Predicate, value interface{}) (bool, error) {
+	items, isList := value.([]interface{})
+	if p.Operator == "with" && !isList {
+		if value == nil {
+			return false, nil
+		}
+		return e.any(p.Block, value)
+	}
+	if !isList {
+		return false, nil
+	}
+
+	matches := 0
+	for _, item := range items {
+		ok, err := e.any(p.Block, item)
+		if err != nil {
+			return false, err
+		}
+		if ok {
+			matches++
+		}
+	}
+
+	switch p.Operator {
+	case "contain", "contain-any", "with":
+		return matches > 0, nil
+	case "contain-all":
+		return matches == len(items), nil
+	case "contain-none":
+		return matches == 0, nil
+	case "contain-single":
+		return matches == 1, nil
+	}
+
+	return false, e.errorf(p.Pos, "unsupported operator %q", p.Operator)
+}
+
+func (e *evaluator) any(exprs []Expr, context interface{}) (bool, error) {
+	for _, expr := range exprs {
+		ok, err := e.eval(expr, context)
+		if err != nil || ok {
+			return ok, err
+		}
+	}
+
+	return false, nil
+}
+
+// operand returns the value of a literal, or of a property of context, nil when the property doesn't exist
+func (e *evaluator) operand(o Operand, context interface{}) interface{} {
+	switch o.Kind {
+	case OperandString, OperandRegex:
+		return o.Value
+	case OperandNumber:
+		n, _ := strconv.ParseFloat(o.Value, 64)
+		return n
+	case OperandBool:
+		return o.Value == "true"
+	case OperandNull:
+		return nil
+	}
+
+	value := context
+	for _, name := range strings.Split(o.Value, ".") {
+		if name == "$" {
+			continue
+		}
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		if value, ok = object[name]; !ok {
+			value = lookupFold(object, name)
+		}
+	}
+
+	return value
+}
+
+// lookupFold finds a property whose name differs in case only
+func lookupFold(object map[string]interface{}, name string) interface{} {
+	for key, value := range object {
+		if strings.EqualFold(key, name) {
+			return value
+		}
+	}
+
+	return nil
+}
+
+func (e *evaluator) call(call Call, value, context interface{}) (interface{}, error) {
+	args := make([]interface{}, len(call.Args))
+	for i, arg := range call.Args {
+		args[i] = e.operand(arg, context)
+	}
+
+	switch strings.ToLower(call.Name) {
+	case "length":
+		switch v := value.(type) {
+		case []interface{}:
+			return float64(len(v)), nil
+		case map[string]interface{}:
+			return float64(len(v)), nil
+		case string:
+			return float64(len([]rune(v))), nil
+		}
+		return float64(0), nil
+	case "isempty":
+		return isEmpty(value), nil
+	case "getvalue":
+		if len(args) != 1 {
+			return nil, e.errorf(call.Pos, "getValue expects the key of the value")
+		}
+		return getValue(value, fmt.Sprint(args[0])), nil
+	case "before", "after":
+		if len(args) != 2 {
+			return nil, e.errorf(call.Pos, "%s expects an amount and a unit such as (-90, 'days')", call.Name)
+		}
+		limit, err := addDuration(e.now, args[0], fmt.Sprint(args[1]))
+		if err != nil {
+			return nil, e.errorf(call.Pos, "%s", err)
+		}
+		date, ok := parseDate(value)
+		if !ok {
+			return false, nil
+		}
+		if strings.EqualFold(call.Name, "before") {
+			return date.Before(limit), nil
+		}
+		return date.After(limit), nil
+	}
+
+	return nil, e.errorf(call.Pos, "unsupported function %s()", call.Name)
+}
+
+// getValue returns the value of key in a list of key/value pairs such as the tags, or in an object
+func getValue(value interface{}, key string) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		return v[key]
+	case []interface{}:
+		for _, item := range v {
+			if pair, ok := item.(map[string]interface{}); ok && fmt.Sprint(lookupFold(pair, "key")) == key {
+				return lookupFold(pair, "value")
+			}
+		}
+	}
+
+	return nil
+}
+
+func addDuration(now time.Time, amount interface{}, unit string) (time.Time, error) {
+	n, ok := amount.(float64)
+	if !ok {
+		return time.Time{}, fmt.Errorf("invalid amount %v", amount)
+	}
+
+	switch strings.TrimSuffix(strings.ToLower(unit), "s") {
+	case "second":
+		return now.Add(time.Duration(n * float64(time.Second))), nil
+	case "minute":
+		return now.Add(time.Duration(n * float64(time.Minute))), nil
+	case "hour":
+		return now.Add(time.Duration(n * float64(time.Hour))), nil
+	case "day":
+		return now.AddDate(0, 0, int(n)), nil
+	case "week":
+		return now.AddDate(0, 0, 7*int(n)), nil
+	case "month":
+		return now.AddDate(0, int(n), 0), nil
+	case "year":
+		return now.AddDate(int(n), 0, 0), nil
+	}
+
+	return time.Time{}, fmt.Errorf("unsupported unit %q", unit)
+}
+
+func parseDate(value interface{}) (time.Time, bool) {
+	s, ok := value.(string)
+	if !ok {
+		return time.Time{}, false
+	}
+	for _, layout := range []string{time.RFC3339Nano, "2006-01-02T15:04:05", "2006-01-02"} {
+		if date, err := time.Parse(layout, s); err == nil {
+			return date, true
+		}
+	}
+
+	return time.Time{}, false
+}
+
+func isEmpty(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case []interface{}:
+		return len(v) == 0
+	case map[string]interface{}:
+		return len(v) == 0
+	}
+
+	return false
+}
+
+func truthy(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return false
+	case bool:
+		return v
+	case string:
+		return v != ""
+	case []interface{}:
+		return len(v) > 0
+	case map[string]interface{}:
+		return len(v) > 0
+	}
+
+	return true
+}
+
+// equal compares the values, numbers and booleans being equal to their text
+func equal(a, b interface{}) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	if reflect.DeepEqual(a, b) {
+		return true
+	}
+
+	if x, ok := number(a); ok {
+		if y, ok := number(b); ok {
+			return x == y
+		}
+	}
+	if isScalar(a) && isScalar(b) {
+		return fmt.Sprint(a) == fmt.Sprint(b)
+	}
+
+	return false
+}
+
+func compare(op string, a, b interface{}) bool {
+	x, ok := number(a)
+	if !ok {
+		return false
+	}
+	y, ok := number(b)
+	if !ok {
+		return false
+	}
+
+	switch op {
+	case "<":
+		return x < y
+	case ">":
+		return x > y
+	case "<=":
+		return x <= y
+	}
+
+	return x >= y
+}
+
+func number(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case float64:
+		return v, true
+	case string:
+		n, err := strconv.ParseFloat(v, 64)
+		return n, err == nil
+	}
+
+	return 0, false
+}
+
+func isScalar(value interface{}) bool {
+	switch value.(type) {
+	case string, float64, bool:
+		return true
+	}
+
+	return false
+}
+
+// like matches value with pattern, % matching any text, case insensitively
+func like(value, pattern interface{}) (bool, error) {
+	s, ok := value.(string)
+	if !ok {
+		return false, nil
+	}
+
+	parts := strings.Split(fmt.Sprint(pattern), "%")
+	for i := range parts {
+		parts[i] = regexp.QuoteMeta(parts[i])
+	}
+	re, err := regexp.Compile("(?is)^" + strings.Join(parts, ".*") + "$")
+	if err != nil {
+		return false, err
+	}
+
+	return re.MatchString(s), nil
+}
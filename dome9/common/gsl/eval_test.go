@@ -0,0 +1,92 @@
+package gsl
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestEvaluate(t *testing.T) {
+	instance := `{
+		"name": "bastion-prod",
+		"isPublic": true,
+		"launchTime": "2024-01-01T00:00:00Z",
+		"vpc": {"id": "vpc-1"},
+		"tags": [{"key": "env", "value": "prod"}, {"key": "owner", "value": "team-a"}],
+		"inboundRules": [
+			{"port": 22, "portTo": 22, "scope": "10.0.0.0/8"},
+			{"port": 443, "portTo": 443, "scope": "0.0.0.0/0"}
+		],
+		"roles": ["admin", "reader"]
+	}`
+	cases := []struct {
+		logic string
+		want  Result
+	}{
+		{"Instance should have isPublic=true", Passed},
+		{"Instance should have isPublic=false", Failed},
+		{"Instance should not have isPublic", Failed},
+		{"Instance where name like 'bastion%' should have vpc.id != ''", Passed},
+		{"Instance where name like 'web%' should have vpc.id != ''", NotRelevant},
+		{"Instance should have name unlike '%PROD'", Failed},
+		{"Instance should have name regexMatch /^bastion-(dev|prod)$/", Passed},
+		{"Instance should have name regexMatch /^BASTION-/", Failed},
+		{"Instance should have name regexMatch /^BASTION-/i", Passed},
+		{"Instance should have name regexMatch /prod/y", Failed},
+		{"Instance should not have inboundRules contain [port<=22 and portTo>=22 and scope='0.0.0.0/0']", Passed},
+		{"Instance should have inboundRules contain-all [scope like '10.%']", Failed},
+		{"Instance should have inboundRules contain-single [port > 100]", Passed},
+		{"Instance should have inboundRules contain-none [port in (3389, 5900)]", Passed},
+		{"Instance should have tags contain [key='owner' and value='team-a']", Passed},
+		{"Instance should have tags getValue('env') = 'prod'", Passed},
+		{"Instance should have roles contain-any ['admin', 'root']", Passed},
+		{"Instance should have roles contain [$ like 'read%']", Passed},
+		{"Instance should have inboundRules length() = 2 and missing isEmpty()", Passed},
+		{"Instance should have vpc with [id='vpc-1']", Passed},
+		{"Instance should have launchTime after(-30, 'days')", Failed},
+		{"Instance should have launchTime before(-30, 'days') or (isPublic=false and not missing)", Passed},
+		{"Instance should have vpc.missing = null", Passed},
+	}
+
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	for _, c := range cases {
+		rule, err := ParseRule(c.logic)
+		if err != nil {
+			t.Fatalf("ParseRule(%q): %s", c.logic, err)
+		}
+		entity := decodeEntity(t, instance)
+		if got, err := rule.EvaluateAt(entity, now); err != nil || got != c.want {
+			t.Errorf("%q: got %q and error %v, want %q", c.logic, got, err, c.want)
+		}
+	}
+}
+
+func TestEvaluateErrors(t *testing.T) {
+	cases := []struct {
+		logic   string
+		entity  string
+		message string
+	}{
+		{"Instance should have name isPublicIp()", `{"name": "a"}`, "line 1, column 27: unsupported function isPublicIp()"},
+		{"Instance should have date after(-1, 'fortnights')", `{}`, "line 1, column 27: unsupported unit \"fortnights\""},
+		{"Instance should have name regexMatch /(/", `{"name": "a"}`, "line 1, column 38: invalid regular expression: error parsing regexp: missing closing ): `(`"},
+		{"Instance should have isPublic=", `{}`, "line 1, column 31: unexpected end of the expression, expected a value"},
+		{"Instance should have isPublic", `{`, "invalid entity JSON: unexpected end of JSON input"},
+	}
+
+	for _, c := range cases {
+		if _, err := Evaluate(c.logic, []byte(c.entity)); err == nil || err.Error() != c.message {
+			t.Errorf("%q: got error %v, want %q", c.logic, err, c.message)
+		}
+	}
+}
+
+func decodeEntity(t *testing.T, entity string) interface{} {
+	t.Helper()
+	var v interface{}
+	if err := json.Unmarshal([]byte(entity), &v); err != nil {
+		t.Fatal(err)
+	}
+
+	return v
+}
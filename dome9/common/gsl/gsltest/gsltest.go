@@ -0,0 +1,61 @@
+// Package gsltest checks GSL rules against sample entities in Go tests, so that the fixtures of the compliant and
+// the non-compliant entities of a ruleset can be kept next to it and asserted in CI:
+//
+//	func TestPublicInstances(t *testing.T) {
+//		gsltest.CheckDir(t, "Instance should have isPublic=false", "testdata/public_instances")
+//	}
+package gsltest
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-dome9/dome9/common/gsl"
+)
+
+// Check fails the test when logic doesn't evaluate to want for the JSON of an entity
+func Check(t testing.TB, logic, entityJSON string, want gsl.Result) {
+	t.Helper()
+
+	got, err := gsl.Evaluate(logic, []byte(entityJSON))
+	if err != nil {
+		t.Errorf("failed evaluating %q: %s", logic, err)
+		return
+	}
+	if got != want {
+		t.Errorf("%q: got %s, want %s for entity %s", logic, got, want, entityJSON)
+	}
+}
+
+// CheckDir checks logic against the JSON files of the passed, failed and not_relevant sub-directories of dir, the
+// entities of each directory having to evaluate to its result. Every file is checked in its own sub-test.
+func CheckDir(t *testing.T, logic, dir string) {
+	t.Helper()
+
+	checked := 0
+	for _, want := range []gsl.Result{gsl.Passed, gsl.Failed, gsl.NotRelevant} {
+		files, err := filepath.Glob(filepath.Join(dir, string(want), "*.json"))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, file := range files {
+			entityJSON, err := ioutil.ReadFile(file)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			name := string(want) + "/" + strings.TrimSuffix(filepath.Base(file), ".json")
+			t.Run(name, func(t *testing.T) {
+				Check(t, logic, string(entityJSON), want)
+			})
+			checked++
+		}
+	}
+
+	if checked == 0 {
+		t.Errorf("no entity found in the passed, failed and not_relevant directories of %s", dir)
+	}
+}
@@ -0,0 +1,35 @@
+package gsltest
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-dome9/dome9/common/gsl"
+)
+
+// recorder records the errors of Check instead of failing the test
+type recorder struct {
+	testing.TB
+	errors []string
+}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Errorf(format string, args ...interface{}) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+func TestCheck(t *testing.T) {
+	Check(t, "Instance should have isPublic=false", `{"isPublic": false}`, gsl.Passed)
+
+	r := &recorder{TB: t}
+	Check(r, "Instance should have isPublic=false", `{"isPublic": true}`, gsl.Passed)
+	want := `"Instance should have isPublic=false": got failed, want passed for entity {"isPublic": true}`
+	if len(r.errors) != 1 || r.errors[0] != want {
+		t.Errorf("got errors %q, want %q", r.errors, want)
+	}
+}
+
+func TestCheckDir(t *testing.T) {
+	CheckDir(t, "Instance where name != 'bastion' should have isPublic=false", "testdata/public_instances")
+}
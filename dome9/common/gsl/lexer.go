@@ -0,0 +1,266 @@
+// Package gsl parses the Dome9 Governance Specification Language, the language of the compliance rules logic and
+// of the exclusions logic expressions, e.g. "Instance where tags contain [key='env'] should have isPublic=false".
+package gsl
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Pos is a position in a GSL text, lines and columns start at 1 and count characters
+type Pos struct {
+	Line   int
+	Column int
+}
+
+func (p Pos) String() string {
+	return fmt.Sprintf("line %d, column %d", p.Line, p.Column)
+}
+
+// Error is a syntax error of a GSL text
+type Error struct {
+	Pos     Pos
+	Message string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s: %s", e.Pos, e.Message)
+}
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenIdent
+	tokenString
+	tokenNumber
+	tokenRegex
+	tokenOperator
+	tokenLParen
+	tokenRParen
+	tokenLBracket
+	tokenRBracket
+	tokenComma
+)
+
+type token struct {
+	kind tokenKind
+	// text is the value of the token, without the quotes or the slashes of strings and regular expressions
+	text string
+	// flags are the flags following a regular expression, e.g. "i" for /foo/i
+	flags string
+	pos   Pos
+}
+
+func (t token) String() string {
+	switch t.kind {
+	case tokenEOF:
+		return "end of the expression"
+	case tokenString:
+		return fmt.Sprintf("'%s'", t.text)
+	case tokenRegex:
+		return fmt.Sprintf("/%s/%s", t.text, t.flags)
+	}
+
+	return fmt.Sprintf("%q", t.text)
+}
+
+// comparisonOperators are the valid operators made of symbols
+var comparisonOperators = map[string]bool{"=": true, "!=": true, "<": true, ">": true, "<=": true, ">=": true}
+
+type lexer struct {
+	src    string
+	offset int
+	pos    Pos
+}
+
+// tokenize splits src into tokens, the last one being tokenEOF
+func tokenize(src string) ([]token, error) {
+	l := &lexer{src: src, pos: Pos{Line: 1, Column: 1}}
+	var tokens []token
+	for {
+		t, err := l.next()
+		if err != nil {
+			return nil, err
+		}
+		tokens = append(tokens, t)
+		if t.kind == tokenEOF {
+			return tokens, nil
+		}
+	}
+}
+
+func (l *lexer) peek() rune {
+	if l.offset >= len(l.src) {
+		return -1
+	}
+	r, _ := utf8.DecodeRuneInString(l.src[l.offset:])
+
+	return r
+}
+
+func (l *lexer) advance() rune {
+	r, size := utf8.DecodeRuneInString(l.src[l.offset:])
+	l.offset += size
+	if r == '\n' {
+		l.pos.Line++
+		l.pos.Column = 1
+	} else {
+		l.pos.Column++
+	}
+
+	return r
+}
+
+func (l *lexer) next() (token, error) {
+	for unicode.IsSpace(l.peek()) {
+		l.advance()
+	}
+
+	start := l.pos
+	r := l.peek()
+	switch {
+	case r == -1:
+		return token{kind: tokenEOF, pos: start}, nil
+	case r == '(':
+		l.advance()
+		return token{kind: tokenLParen, text: "(", pos: start}, nil
+	case r == ')':
+		l.advance()
+		return token{kind: tokenRParen, text: ")", pos: start}, nil
+	case r == '[':
+		l.advance()
+		return token{kind: tokenLBracket, text: "[", pos: start}, nil
+	case r == ']':
+		l.advance()
+		return token{kind: tokenRBracket, text: "]", pos: start}, nil
+	case r == ',':
+		l.advance()
+		return token{kind: tokenComma, text: ",", pos: start}, nil
+	case r == '\'' || r == '"':
+		return l.quoted(tokenString, r, "string")
+	case r == '/':
+		return l.regex()
+	case unicode.IsDigit(r) || (r == '-' && l.nextIsDigit()):
+		return l.number(), nil
+	case isIdentStart(r):
+		return l.ident(), nil
+	case strings.ContainsRune(operatorRunes, r):
+		return l.operator()
+	}
+
+	return token{}, &Error{Pos: start, Message: fmt.Sprintf("unexpected character %q", r)}
+}
+
+// operatorRunes are the runes of the operators made of symbols, including the invalid ones such as "==" or "&&"
+const operatorRunes = "=!<>&|~^*+%:;"
+
+func (l *lexer) operator() (token, error) {
+	start, begin := l.pos, l.offset
+	for l.peek() != -1 && strings.ContainsRune(operatorRunes, l.peek()) {
+		l.advance()
+	}
+
+	text := l.src[begin:l.offset]
+	if !comparisonOperators[text] {
+		return token{}, &Error{Pos: start, Message: fmt.Sprintf("unknown operator %q", text)}
+	}
+
+	return token{kind: tokenOperator, text: text, pos: start}, nil
+}
+
+func (l *lexer) quoted(kind tokenKind, quote rune, name string) (token, error) {
+	start := l.pos
+	l.advance()
+
+	var b strings.Builder
+	for {
+		r := l.peek()
+		switch r {
+		case -1:
+			return token{}, &Error{Pos: start, Message: fmt.Sprintf("unterminated %s", name)}
+		case '\\':
+			l.advance()
+			if l.peek() == -1 {
+				return token{}, &Error{Pos: start, Message: fmt.Sprintf("unterminated %s", name)}
+			}
+			escaped := l.advance()
+			// regular expressions keep their escapes, except for the escaped slashes
+			if kind == tokenRegex && escaped != quote {
+				b.WriteRune('\\')
+			}
+			b.WriteRune(escaped)
+		case quote:
+			l.advance()
+			return token{kind: kind, text: b.String(), pos: start}, nil
+		default:
+			b.WriteRune(l.advance())
+		}
+	}
+}
+
+// regexFlags are the valid flags of the regular expressions
+const regexFlags = "gimsuy"
+
+// regex reads a regular expression and its flags, such as /^prod-/i
+func (l *lexer) regex() (token, error) {
+	t, err := l.quoted(tokenRegex, '/', "regular expression")
+	if err != nil {
+		return token{}, err
+	}
+
+	begin := l.offset
+	for isIdentStart(l.peek()) {
+		pos, r := l.pos, l.peek()
+		if !strings.ContainsRune(regexFlags, r) || strings.ContainsRune(l.src[begin:l.offset], r) {
+			return token{}, &Error{Pos: pos, Message: fmt.Sprintf("invalid regular expression flag %q, expected one of %q", r, regexFlags)}
+		}
+		l.advance()
+	}
+	t.flags = l.src[begin:l.offset]
+
+	return t, nil
+}
+
+func (l *lexer) nextIsDigit() bool {
+	r, size := utf8.DecodeRuneInString(l.src[l.offset:])
+	if r != '-' {
+		return false
+	}
+	next, _ := utf8.DecodeRuneInString(l.src[l.offset+size:])
+
+	return unicode.IsDigit(next)
+}
+
+func (l *lexer) number() token {
+	start, begin := l.pos, l.offset
+	if l.peek() == '-' {
+		l.advance()
+	}
+	for unicode.IsDigit(l.peek()) || l.peek() == '.' {
+		l.advance()
+	}
+
+	return token{kind: tokenNumber, text: l.src[begin:l.offset], pos: start}
+}
+
+func isIdentStart(r rune) bool {
+	return unicode.IsLetter(r) || r == '_' || r == '$'
+}
+
+// ident reads identifiers and property paths such as "encryption.serverSideEncryptionRules", the operators such as
+// "contain-any" being identifiers as well
+func (l *lexer) ident() token {
+	start, begin := l.pos, l.offset
+	for {
+		r := l.peek()
+		if !isIdentStart(r) && !unicode.IsDigit(r) && r != '.' && r != '-' {
+			break
+		}
+		l.advance()
+	}
+
+	return token{kind: tokenIdent, text: l.src[begin:l.offset], pos: start}
+}
@@ -0,0 +1,462 @@
+package gsl
+
+import (
+	"fmt"
+	"strings"
+)
+
+// blockOperators are followed by a bracketed condition on the items of the property
+var blockOperators = map[string]bool{
+	"contain":        true,
+	"contain-any":    true,
+	"contain-all":    true,
+	"contain-none":   true,
+	"contain-single": true,
+	"with":           true,
+}
+
+// valueOperators are followed by a single value
+var valueOperators = map[string]bool{
+	"like":       true,
+	"unlike":     true,
+	"regexmatch": true,
+}
+
+// keywords end a predicate
+var keywords = map[string]bool{
+	"where":  true,
+	"should": true,
+	"not":    true,
+	"have":   true,
+	"and":    true,
+	"or":     true,
+}
+
+// ParseRule parses the logic of a compliance rule, e.g. "Instance where isPublic=true should have vpc.id != null"
+func ParseRule(src string) (*Rule, error) {
+	p, err := newParser(src)
+	if err != nil {
+		return nil, err
+	}
+
+	rule, err := p.entityFilter()
+	if err != nil {
+		return nil, err
+	}
+	if err := p.should(rule); err != nil {
+		return nil, err
+	}
+
+	return rule, p.end()
+}
+
+// ParseLogicExpression parses a logic expression of the exclusions and the remediations: an entity filter such as
+// "S3Bucket where name like 'logs%'", a rule, or a condition on the entities of the rule such as "name='logs'" whose
+// Rule has no Entity and the condition as Where
+func ParseLogicExpression(src string) (*Rule, error) {
+	p, err := newParser(src)
+	if err != nil {
+		return nil, err
+	}
+
+	if next := p.peek(); p.current().kind == tokenIdent && (next.kind == tokenEOF ||
+		next.kind == tokenIdent && (strings.EqualFold(next.text, "where") || strings.EqualFold(next.text, "should"))) {
+		rule, err := p.entityFilter()
+		if err != nil {
+			return nil, err
+		}
+		if p.isKeyword("should") {
+			if err := p.should(rule); err != nil {
+				return nil, err
+			}
+		}
+
+		return rule, p.end()
+	}
+
+	where, err := p.expression()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Rule{Where: where}, p.end()
+}
+
+type parser struct {
+	tokens []token
+	i      int
+}
+
+func newParser(src string) (*parser, error) {
+	tokens, err := tokenize(src)
+	if err != nil {
+		return nil, err
+	}
+	if tokens[0].kind == tokenEOF {
+		return nil, &Error{Pos: tokens[0].pos, Message: "empty GSL expression"}
+	}
+
+	return &parser{tokens: tokens}, nil
+}
+
+func (p *parser) current() token {
+	return p.tokens[p.i]
+}
+
+// peek returns the token after the current one, the EOF token at the end of the expression
+func (p *parser) peek() token {
+	if p.i+1 < len(p.tokens) {
+		return p.tokens[p.i+1]
+	}
+
+	return p.tokens[len(p.tokens)-1]
+}
+
+func (p *parser) advance() token {
+	t := p.tokens[p.i]
+	if t.kind != tokenEOF {
+		p.i++
+	}
+
+	return t
+}
+
+func (p *parser) isKeyword(keyword string) bool {
+	t := p.current()
+	return t.kind == tokenIdent && strings.EqualFold(t.text, keyword)
+}
+
+func (p *parser) expectKeyword(keyword string) (token, error) {
+	if !p.isKeyword(keyword) {
+		return token{}, p.unexpected(fmt.Sprintf("%q", keyword))
+	}
+
+	return p.advance(), nil
+}
+
+// unexpected reports the current token, the unbalanced closing parentheses and brackets being reported as such
+func (p *parser) unexpected(expected string) error {
+	t := p.current()
+	switch {
+	case t.kind == tokenRParen && !p.isOpen(tokenLParen, tokenRParen):
+		return &Error{Pos: t.pos, Message: "unbalanced ')' without a matching '('"}
+	case t.kind == tokenRBracket && !p.isOpen(tokenLBracket, tokenRBracket):
+		return &Error{Pos: t.pos, Message: "unbalanced ']' without a matching '['"}
+	}
+
+	return &Error{Pos: t.pos, Message: fmt.Sprintf("unexpected %s, expected %s", t, expected)}
+}
+
+// isOpen tells whether a parenthesis or a bracket is left open before the current token
+func (p *parser) isOpen(opening, closing tokenKind) bool {
+	depth := 0
+	for _, t := range p.tokens[:p.i] {
+		switch t.kind {
+		case opening:
+			depth++
+		case closing:
+			depth--
+		}
+	}
+
+	return depth > 0
+}
+
+// closing expects the closing parenthesis or bracket of opening
+func (p *parser) closing(opening token, kind tokenKind, text string) error {
+	if p.current().kind == kind {
+		p.advance()
+		return nil
+	}
+	if p.current().kind == tokenEOF {
+		return &Error{Pos: opening.pos, Message: fmt.Sprintf("unbalanced '%s', missing '%s'", opening.text, text)}
+	}
+
+	return p.unexpected(fmt.Sprintf("'%s' closing the '%s' at %s", text, opening.text, opening.pos))
+}
+
+func (p *parser) end() error {
+	if p.current().kind != tokenEOF {
+		return p.unexpected("the end of the expression or an \"and\" or \"or\" operator")
+	}
+
+	return nil
+}
+
+// should parses "should [not] [have] <condition>"
+func (p *parser) should(rule *Rule) error {
+	if _, err := p.expectKeyword("should"); err != nil {
+		return err
+	}
+	if p.isKeyword("not") {
+		p.advance()
+		rule.Not = true
+	}
+	if p.isKeyword("have") {
+		p.advance()
+	}
+
+	should, err := p.expression()
+	if err != nil {
+		return err
+	}
+	rule.Should = should
+
+	return nil
+}
+
+// entityFilter parses "<Entity> [where <condition>]"
+func (p *parser) entityFilter() (*Rule, error) {
+	t := p.current()
+	if t.kind != tokenIdent || keywords[strings.ToLower(t.text)] {
+		return nil, p.unexpected("an entity type such as \"Instance\"")
+	}
+	p.advance()
+
+	rule := &Rule{Entity: t.text}
+	if p.isKeyword("where") {
+		p.advance()
+		where, err := p.expression()
+		if err != nil {
+			return nil, err
+		}
+		rule.Where = where
+	}
+
+	return rule, nil
+}
+
+func (p *parser) expression() (Expr, error) {
+	return p.binary("or", p.and)
+}
+
+func (p *parser) and() (Expr, error) {
+	return p.binary("and", p.not)
+}
+
+func (p *parser) binary(op string, operand func() (Expr, error)) (Expr, error) {
+	left, err := operand()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.isKeyword(op) {
+		t := p.advance()
+		right, err := operand()
+		if err != nil {
+			return nil, err
+		}
+		left = &Binary{Pos: t.pos, Op: op, Left: left, Right: right}
+	}
+
+	return left, nil
+}
+
+func (p *parser) not() (Expr, error) {
+	if p.isKeyword("not") {
+		t := p.advance()
+		expr, err := p.not()
+		if err != nil {
+			return nil, err
+		}
+
+		return &Not{Pos: t.pos, Expr: expr}, nil
+	}
+
+	return p.primary()
+}
+
+func (p *parser) primary() (Expr, error) {
+	if p.current().kind != tokenLParen {
+		return p.predicate()
+	}
+
+	opening := p.advance()
+	expr, err := p.expression()
+	if err != nil {
+		return nil, err
+	}
+	if err := p.closing(opening, tokenRParen, ")"); err != nil {
+		return nil, err
+	}
+
+	return expr, nil
+}
+
+func (p *parser) predicate() (Expr, error) {
+	subject, err := p.operand("a property or a value")
+	if err != nil {
+		return nil, err
+	}
+	predicate := &Predicate{Pos: subject.Pos, Subject: subject}
+
+	for {
+		t := p.current()
+		if t.kind == tokenOperator {
+			p.advance()
+			predicate.Operator = t.text
+			value, err := p.operand("a value")
+			if err != nil {
+				return nil, err
+			}
+			predicate.Args = []Operand{value}
+			return predicate, nil
+		}
+		if t.kind != tokenIdent {
+			return predicate, nil
+		}
+
+		name := strings.ToLower(t.text)
+		if name == "not" && p.nextIsOperator() {
+			p.advance()
+			predicate.Negated = true
+			continue
+		}
+
+		switch {
+		case name == "in":
+			p.advance()
+			predicate.Operator = name
+			if predicate.Args, err = p.list(); err != nil {
+				return nil, err
+			}
+			return predicate, nil
+		case valueOperators[name]:
+			p.advance()
+			predicate.Operator = name
+			value, err := p.operand("a value")
+			if err != nil {
+				return nil, err
+			}
+			predicate.Args = []Operand{value}
+			return predicate, nil
+		case blockOperators[name]:
+			p.advance()
+			predicate.Operator = name
+			if predicate.Block, err = p.block(); err != nil {
+				return nil, err
+			}
+			return predicate, nil
+		case keywords[name]:
+			return predicate, nil
+		case p.peek().kind == tokenLParen:
+			call, err := p.call()
+			if err != nil {
+				return nil, err
+			}
+			predicate.Calls = append(predicate.Calls, call)
+		default:
+			return nil, &Error{Pos: t.pos, Message: fmt.Sprintf("unknown operator %q", t.text)}
+		}
+	}
+}
+
+// nextIsOperator tells whether the token after the current one is a word operator, as in "name not like 'prod%'"
+func (p *parser) nextIsOperator() bool {
+	next := p.peek()
+	name := strings.ToLower(next.text)
+
+	return next.kind == tokenIdent && (name == "in" || valueOperators[name] || blockOperators[name])
+}
+
+func (p *parser) operand(expected string) (Operand, error) {
+	t := p.current()
+	switch t.kind {
+	case tokenString:
+		p.advance()
+		return Operand{Pos: t.pos, Kind: OperandString, Value: t.text}, nil
+	case tokenNumber:
+		p.advance()
+		return Operand{Pos: t.pos, Kind: OperandNumber, Value: t.text}, nil
+	case tokenRegex:
+		p.advance()
+		return Operand{Pos: t.pos, Kind: OperandRegex, Value: t.text, Flags: t.flags}, nil
+	case tokenIdent:
+		name := strings.ToLower(t.text)
+		switch {
+		case name == "true" || name == "false":
+			p.advance()
+			return Operand{Pos: t.pos, Kind: OperandBool, Value: name}, nil
+		case name == "null":
+			p.advance()
+			return Operand{Pos: t.pos, Kind: OperandNull, Value: name}, nil
+		case !keywords[name] && !blockOperators[name] && !valueOperators[name] && name != "in":
+			p.advance()
+			return Operand{Pos: t.pos, Kind: OperandPath, Value: t.text}, nil
+		}
+	}
+
+	return Operand{}, p.unexpected(expected)
+}
+
+// list parses "(<value>, ...)" or "[<value>, ...]"
+func (p *parser) list() ([]Operand, error) {
+	opening := p.current()
+	closingKind, closingText := tokenRParen, ")"
+	switch opening.kind {
+	case tokenLParen:
+	case tokenLBracket:
+		closingKind, closingText = tokenRBracket, "]"
+	default:
+		return nil, p.unexpected("a list of values such as ('a', 'b')")
+	}
+	p.advance()
+
+	var values []Operand
+	for p.current().kind != closingKind {
+		if len(values) > 0 {
+			if p.current().kind != tokenComma {
+				return nil, p.closing(opening, closingKind, closingText)
+			}
+			p.advance()
+		}
+		value, err := p.operand("a value")
+		if err != nil {
+			if p.current().kind == tokenEOF {
+				return nil, p.closing(opening, closingKind, closingText)
+			}
+			return nil, err
+		}
+		values = append(values, value)
+	}
+	p.advance()
+
+	return values, nil
+}
+
+// block parses "[<condition>, ...]"
+func (p *parser) block() ([]Expr, error) {
+	if p.current().kind != tokenLBracket {
+		return nil, p.unexpected("'[' opening the condition on the items")
+	}
+	opening := p.advance()
+
+	var exprs []Expr
+	for {
+		expr, err := p.expression()
+		if err != nil {
+			return nil, err
+		}
+		exprs = append(exprs, expr)
+		if p.current().kind != tokenComma {
+			break
+		}
+		p.advance()
+	}
+	if err := p.closing(opening, tokenRBracket, "]"); err != nil {
+		return nil, err
+	}
+
+	return exprs, nil
+}
+
+// call parses "<name>(<value>, ...)"
+func (p *parser) call() (Call, error) {
+	t := p.advance()
+	args, err := p.list()
+	if err != nil {
+		return Call{}, err
+	}
+
+	return Call{Pos: t.pos, Name: t.text, Args: args}, nil
+}
@@ -0,0 +1,143 @@
+package gsl
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseRule(t *testing.T) {
+	rules := []string{
+		"Instance should not have inboundRules with [scope = '0.0.0.0/0' and port<=22 and portTo>=22]",
+		"IamUser where passwordEnabled=true and firstAccessKey.isActive=true should not have firstAccessKey.lastUsedDate before(-90, 'days')",
+		"S3Bucket should have policy.Statement contain-none [Effect='Allow' and Principal='*']",
+		"IamRole where name like '%admin%' should have policies length()=0",
+		"SecurityGroup should not have inboundRules contain [ port<=22 and portTo>=22 and scope isEmpty() ]",
+		"ApplicationLoadBalancer should have listeners contain-all [protocol in('HTTPS', 'TLS')]",
+		"EcsTaskDefinition should not have containerDefinitions contain [ environment contain [ name regexMatch /(?i)(password|secret)/ ] ]",
+		"CloudTrail should have (isMultiRegionTrail=true and isLogging=true) or not isOrganizationTrail=false",
+		"KMS should have deletionDate isEmpty()",
+		"IamPolicy should not have document.Statement contain [Effect='Allow' and Action contain [$ like '%*%']]",
+		"Instance should have tags contain-any [ key='Owner', key='owner' ]",
+		"VMInstance should have name not like 'test%'",
+		"RDS should have backupRetentionPeriod >= 7 and isStorageEncrypted",
+		"Instance where tags getValue('env') = 'prod' should have vpc.id != null",
+		"Lambda\n  should have\n  name unlike \"legacy-%\"",
+		"S3Bucket should have name regexMatch /^prod-[a-z]+$/i",
+		"Instance should not have tags contain [ key regexMatch /secret/gi ]",
+	}
+
+	for _, rule := range rules {
+		if _, err := ParseRule(rule); err != nil {
+			t.Errorf("ParseRule(%q): %s", rule, err)
+		}
+	}
+}
+
+func TestParseRuleTree(t *testing.T) {
+	rule, err := ParseRule("Instance where isPublic=true should not have tags contain [key='env'] or name like 'prod%'")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if rule.Entity != "Instance" || !rule.Not {
+		t.Errorf("got entity %q and not %v", rule.Entity, rule.Not)
+	}
+	where, ok := rule.Where.(*Predicate)
+	if !ok || where.Subject.Value != "isPublic" || where.Operator != "=" || where.Args[0].Kind != OperandBool {
+		t.Errorf("unexpected where %+v", rule.Where)
+	}
+	or, ok := rule.Should.(*Binary)
+	if !ok || or.Op != "or" {
+		t.Fatalf("unexpected should %+v", rule.Should)
+	}
+	contain, ok := or.Left.(*Predicate)
+	if !ok || contain.Operator != "contain" || len(contain.Block) != 1 {
+		t.Errorf("unexpected contain %+v", or.Left)
+	}
+	like, ok := or.Right.(*Predicate)
+	if !ok || like.Operator != "like" || like.Args[0].Value != "prod%" || like.Pos != (Pos{Line: 1, Column: 74}) {
+		t.Errorf("unexpected like %+v", or.Right)
+	}
+}
+
+func TestParseRuleRegexFlags(t *testing.T) {
+	rule, err := ParseRule("S3Bucket should have name regexMatch /^prod-\\/[a-z]+/i")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	match, ok := rule.Should.(*Predicate)
+	if !ok || match.Operator != "regexmatch" || match.Args[0].Kind != OperandRegex {
+		t.Fatalf("unexpected should %+v", rule.Should)
+	}
+	if match.Args[0].Value != "^prod-/[a-z]+" || match.Args[0].Flags != "i" {
+		t.Errorf("got regular expression %q with flags %q", match.Args[0].Value, match.Args[0].Flags)
+	}
+}
+
+func TestParseRuleErrors(t *testing.T) {
+	cases := []struct {
+		rule    string
+		message string
+	}{
+		{"Instance should have isPublic==false", "line 1, column 30: unknown operator \"==\""},
+		{"Instance should have isPublic=false && vpc", "line 1, column 37: unknown operator \"&&\""},
+		{"Instance should have tags contians [key='env']", "line 1, column 27: unknown operator \"contians\""},
+		{"Instance should have (isPublic=false and (vpc.id != '')", "line 1, column 22: unbalanced '(', missing ')'"},
+		{"Instance should have isPublic=false)", "line 1, column 36: unbalanced ')' without a matching '('"},
+		{"Instance should have tags contain [key='env'", "line 1, column 35: unbalanced '[', missing ']'"},
+		{"Instance should have name in ('a', 'b'", "line 1, column 30: unbalanced '(', missing ')'"},
+		{"Instance should have name='prod", "line 1, column 27: unterminated string"},
+		{"Instance have isPublic=false", "line 1, column 10: unexpected \"have\", expected \"should\""},
+		{"Instance should have\n  name = ", "line 2, column 10: unexpected end of the expression, expected a value"},
+		{"Instance should have tags contain key='env'", "line 1, column 35: unexpected \"key\", expected '[' opening the condition on the items"},
+		{"should have isPublic=false", "line 1, column 1: unexpected \"should\", expected an entity type such as \"Instance\""},
+		{"Instance should have name = 'a' 'b'", "line 1, column 33: unexpected 'b', expected the end of the expression or an \"and\" or \"or\" operator"},
+		{"Instance should have name # 'a'", "line 1, column 27: unexpected character '#'"},
+		{"", "line 1, column 1: empty GSL expression"},
+		{"Instance should have name regexMatch /prod/q", "line 1, column 44: invalid regular expression flag 'q', expected one of \"gimsuy\""},
+		{"Instance should have name regexMatch /prod/ii", "line 1, column 45: invalid regular expression flag 'i', expected one of \"gimsuy\""},
+		{" \n  ", "line 2, column 3: empty GSL expression"},
+	}
+
+	for _, c := range cases {
+		_, err := ParseRule(c.rule)
+		if err == nil || err.Error() != c.message {
+			t.Errorf("ParseRule(%q): got error %v, want %q", c.rule, err, c.message)
+		}
+		if _, ok := err.(*Error); err != nil && !ok {
+			t.Errorf("ParseRule(%q): got a %T, want a *Error", c.rule, err)
+		}
+	}
+}
+
+func TestParseLogicExpression(t *testing.T) {
+	expressions := []string{
+		"S3Bucket where name like 'logs%'",
+		"Instance",
+		"Instance where tags contain [key='env' and value='dev']",
+		"S3Bucket should have versioning.enabled=true",
+		"name='bastion' or tags contain [key='env' and value='dev']",
+	}
+
+	for _, expression := range expressions {
+		if _, err := ParseLogicExpression(expression); err != nil {
+			t.Errorf("ParseLogicExpression(%q): %s", expression, err)
+		}
+	}
+
+	rule, err := ParseLogicExpression("name='bastion'")
+	if err != nil || rule.Entity != "" || rule.Where == nil || rule.Should != nil {
+		t.Errorf("got %+v and error %v, want a condition without entity", rule, err)
+	}
+
+	for _, empty := range []string{"", "  \t"} {
+		if _, err := ParseLogicExpression(empty); err == nil || !strings.HasSuffix(err.Error(), "empty GSL expression") {
+			t.Errorf("ParseLogicExpression(%q): got error %v, want an empty GSL expression error", empty, err)
+		}
+	}
+
+	if _, err := ParseLogicExpression("Instance where (name='a'"); err == nil || err.Error() != "line 1, column 16: unbalanced '(', missing ')'" {
+		t.Errorf("got error %v for an unbalanced expression", err)
+	}
+}
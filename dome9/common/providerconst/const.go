@@ -2,8 +2,11 @@ package providerconst
 
 // Provider environment variable
 const (
-	ProviderAccessIDEnvVariable  = "DOME9_ACCESS_ID"
-	ProviderSecretKeyEnvVariable = "DOME9_SECRET_KEY"
+	ProviderAccessIDEnvVariable              = "DOME9_ACCESS_ID"
+	ProviderSecretKeyEnvVariable             = "DOME9_SECRET_KEY"
+	ProviderRegionEnvVariable                = "DOME9_REGION"
+	ProviderProfileEnvVariable               = "DOME9_PROFILE"
+	ProviderSharedCredentialsFileEnvVariable = "DOME9_SHARED_CREDENTIALS_FILE"
 )
 
 // SDK parameters names
@@ -11,6 +14,38 @@ const (
 	ProviderAccessID  = "dome9_access_id"
 	ProviderSecretKey = "dome9_secret_key"
 	ProviderBaseURL   = "base_url"
+	ProviderRegion    = "region"
+
+	ProviderSkipCredentialsValidation = "skip_credentials_validation"
+)
+
+// Shared credentials file
+const (
+	ProviderProfile               = "profile"
+	ProviderSharedCredentialsFile = "shared_credentials_file"
+	DefaultProfile                = "default"
+	DefaultSharedCredentialsFile  = "~/.dome9/credentials"
+)
+
+// CloudGuard data centers API URLs
+var RegionBaseURLs = map[string]string{
+	"us":    "https://api.dome9.com/v2/",
+	"eu1":   "https://api.eu1.dome9.com/v2/",
+	"ap1":   "https://api.ap1.dome9.com/v2/",
+	"ap2":   "https://api.ap2.dome9.com/v2/",
+	"ap3":   "https://api.ap3.dome9.com/v2/",
+	"cace1": "https://api.cace1.dome9.com/v2/",
+}
+var Regions = []string{"us", "eu1", "ap1", "ap2", "ap3", "cace1"}
+
+// HTTP client parameters names
+const (
+	ProviderMaxRetries     = "max_retries"
+	ProviderMinBackoff     = "min_backoff"
+	ProviderMaxBackoff     = "max_backoff"
+	ProviderRetryOnStatus  = "retry_on_status"
+	ProviderRequestsPerSec = "requests_per_second"
+	ProviderBurst          = "burst"
 )
 
 // AWS unified onboarding
@@ -47,6 +82,7 @@ const (
 	PostureManagementConfiguration = "posture_management_configuration"
 	ServerlessConfiguration        = "serverless_configuration"
 	IntelligenceConfigurations     = "intelligence_configurations"
+	WaitForCompletion              = "wait_for_completion"
 )
 
 // GCP onboarding
@@ -146,6 +182,9 @@ var AllAWSRegions = append(AWSGOVRegions, append(AWSRegions, AWSChinaRegions...)
 var CloudVendors = []string{"aws", "azure", "google", "kubernetesruntimeassurance", "imageassurance"}
 var ProtocolTypes = []string{"ALL", "HOPOPT", "ICMP", "IGMP", "GGP", "IPV4", "ST", "TCP", "CBT", "EGP", "IGP", "BBN_RCC_MON", "NVP2", "PUP", "ARGUS", "EMCON", "XNET", "CHAOS", "UDP", "MUX", "DCN_MEAS", "HMP", "PRM", "XNS_IDP", "TRUNK1", "TRUNK2", "LEAF1", "LEAF2", "RDP", "IRTP", "ISO_TP4", "NETBLT", "MFE_NSP", "MERIT_INP", "DCCP", "ThreePC", "IDPR", "XTP", "DDP", "IDPR_CMTP", "TPplusplus", "IL", "IPV6", "SDRP", "IPV6_ROUTE", "IPV6_FRAG", "IDRP", "RSVP", "GRE", "DSR", "BNA", "ESP", "AH", "I_NLSP", "SWIPE", "NARP", "MOBILE", "TLSP", "SKIP", "ICMPV6", "IPV6_NONXT", "IPV6_OPTS", "CFTP", "SAT_EXPAK", "KRYPTOLAN", "RVD", "IPPC", "SAT_MON", "VISA", "IPCV", "CPNX", "CPHB", "WSN", "PVP", "BR_SAT_MON", "SUN_ND", "WB_MON", "WB_EXPAK", "ISO_IP", "VMTP", "SECURE_VMTP", "VINES", "TTP", "NSFNET_IGP", "DGP", "TCF", "EIGRP", "OSPFIGP", "SPRITE_RPC", "LARP", "MTP", "AX25", "IPIP", "MICP", "SCC_SP", "ETHERIP", "ENCAP", "GMTP", "IFMP", "PNNI", "PIM", "ARIS", "SCPS", "QNX", "AN", "IPCOMP", "SNP", "COMPAQ_PEER", "IPX_IN_IP", "VRRP", "PGM", "L2TP", "DDX", "IATP", "STP", "SRP", "UTI", "SMP", "SM", "PTP", "ISIS", "FIRE", "CRTP", "CRUDP", "SSCOPMCE", "IPLT", "SPS", "PIPE", "SCTP", "FC", "RSVP_E2E_IGNORE", "MOBILITY_HEADER", "UDPLITE", "MPLS_IN_IP", "MANET", "HIP", "SHIM6", "WESP", "ROHC"}
 var OperationMode = []string{"Read", "Manage"}
+
+// The feature tiers of the rulesets, from the lowest to the highest
+var FeatureTiers = []string{"Trial", "Basic", "Advanced"}
 var SRLTypes = []string{"AWS", "Azure", "GCP", "OrganizationalUnit", "CloudGuardResources", "CSPMResources", "NetworkSecurityResources", "CIEMResources", "CDRResources", "CodeSecurityResources"}
 
 var IAMEntityProtectType = []string{IAMSafeEntityTypeUser, IAMSafeEntityTypeRole}
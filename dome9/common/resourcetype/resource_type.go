@@ -15,6 +15,7 @@ const (
 	Notification                                 = "dome9_notification"
 	Integration                                  = "dome9_integration"
 	RuleSet                                      = "dome9_ruleset"
+	RuleSetRule                                  = "dome9_ruleset_rule"
 	CloudAccountAWSSecurityGroup                 = "dome9_aws_security_group"
 	CloudAccountAWSSecurityGroupRule             = "dome9_cloud_security_group_rule"
 	Role                                         = "dome9_role"
@@ -39,4 +40,9 @@ const (
 	AWSOrganizationOnboardingMemberAccountConfig = "dome9_aws_organization_onboarding_member_account_configuration"
 	AwpAzureOnboardingData                       = "dome9_awp_azure_onboarding_data"
 	AwpAzureOnboarding                           = "dome9_awp_azure_onboarding"
+	ComplianceExclusion                          = "dome9_compliance_exclusion"
+	ComplianceExclusions                         = "dome9_compliance_exclusions"
+	ComplianceRemediation                        = "dome9_compliance_remediation"
+	RuleSets                                     = "dome9_rulesets"
+	GSLEvaluation                                = "dome9_gsl_evaluation"
 )
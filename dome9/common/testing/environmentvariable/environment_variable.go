@@ -67,3 +67,8 @@ const (
 	CloudAccountOrgAzureEnvVarManagementGroupId = "AZURE_ORG_MGMT_GROUP_ID"
 	CloudAccountOrgAzureEnvVarTenantId          = "AZURE_ORG_TENANT_ID"
 )
+
+// Record and replay of the acceptance tests API calls, "record" or "replay"
+const (
+	CassetteMode = "DOME9_CASSETTE_MODE"
+)
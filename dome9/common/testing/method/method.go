@@ -2,14 +2,68 @@ package method
 
 import (
 	"fmt"
+	"hash/fnv"
+	"math/rand"
+	"os"
+	"runtime"
+	"strings"
+	"sync"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+
+	"github.com/terraform-providers/terraform-provider-dome9/dome9/common/testing/environmentvariable"
 )
 
 func GenerateRandomSourcesTypeAndName(sourceType string) (string, string, string) {
-	name := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	name := randomName()
 	resource := fmt.Sprintf("%s.%s", sourceType, name)
 	dataSource := fmt.Sprintf("data.%s.%s", sourceType, name)
 	return resource, dataSource, name
 
 }
+
+var (
+	namesMu sync.Mutex
+	// names counts the names generated by each test
+	names = make(map[string]int)
+)
+
+// randomName returns a random name of 10 letters. When the API calls are recorded or replayed, the names must be
+// the same on every run: they derive from the name of the calling test and the number of names it generated.
+func randomName() string {
+	if os.Getenv(environmentvariable.CassetteMode) == "" {
+		return acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	}
+
+	test := callingTest()
+	namesMu.Lock()
+	names[test]++
+	seed := fmt.Sprintf("%s/%d", test, names[test])
+	namesMu.Unlock()
+
+	hash := fnv.New64a()
+	_, _ = hash.Write([]byte(seed))
+	random := rand.New(rand.NewSource(int64(hash.Sum64())))
+	name := make([]byte, 10)
+	for i := range name {
+		name[i] = acctest.CharSetAlpha[random.Intn(len(acctest.CharSetAlpha))]
+	}
+
+	return string(name)
+}
+
+// callingTest returns the name of the Test function in the call stack, e.g. "TestAccResourceIPListBasic"
+func callingTest() string {
+	pcs := make([]uintptr, 32)
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(2, pcs)])
+	for {
+		frame, more := frames.Next()
+		function := frame.Function[strings.LastIndex(frame.Function, "/")+1:]
+		if parts := strings.Split(function, "."); len(parts) > 1 && strings.HasPrefix(parts[1], "Test") {
+			return parts[1]
+		}
+		if !more {
+			return ""
+		}
+	}
+}
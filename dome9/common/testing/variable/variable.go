@@ -136,6 +136,20 @@ const (
 	RuleSetDescriptionUpdate = "this is acceptance test"
 )
 
+// rulesets data source
+const (
+	RuleSetsSystemNameRegex = "^AWS CIS Foundations"
+)
+
+// ruleset rule resource
+const (
+	RuleSetRuleID             = "D9.AT.1"
+	RuleSetRuleLogic          = "Instance should have isPublic=false"
+	RuleSetRuleLogicUpdate    = "Instance should have isPublic=false and vpc"
+	RuleSetRuleSeverity       = "High"
+	RuleSetRuleSeverityUpdate = "Critical"
+)
+
 // aws security group resource/data source
 const (
 	AWSSecurityGroupDescription   = "this is aws security group test"
@@ -146,6 +160,14 @@ const (
 	AWSSecurityGroupTagValue = "value"
 )
 
+// aws security group rule resource
+const (
+	AWSSecurityGroupRuleDescription = "inbound-test-aws-sg-rule"
+
+	// Update
+	AWSSecurityGroupRuleUpdatedDescription = "inbound-test-aws-sg-rule-updated"
+)
+
 // azure security group resource/data source
 const (
 	AzureSecurityGroupDescription       = "this is azure security group test"
@@ -305,3 +327,19 @@ const (
 const (
 	AzureOrganizationOnboardingVendorName = "azure"
 )
+
+// compliance exclusion resource/data source
+const (
+	ComplianceExclusionComment       = "this is acceptance test"
+	ComplianceExclusionCommentUpdate = "this is acceptance test update"
+	ComplianceExclusionDateFrom      = "2030-01-01T00:00:00Z"
+	ComplianceExclusionDateTo        = "2030-12-31T00:00:00Z"
+)
+
+// compliance remediation resource
+const (
+	ComplianceRemediationComment       = "this is acceptance test"
+	ComplianceRemediationCommentUpdate = "this is acceptance test update"
+	ComplianceRemediationCloudBot      = "s3_enable_versioning"
+	ComplianceRemediationCloudBotAdded = "s3_enable_encryption"
+)
@@ -0,0 +1,47 @@
+package dome9
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/dome9/dome9-sdk-go/dome9"
+	"github.com/dome9/dome9-sdk-go/services/compliance/compliance_exclusion"
+)
+
+func TestComplianceExclusionIDUnmarshal(t *testing.T) {
+	var exclusions []compliance_exclusion.ComplianceExclusion
+	if err := json.Unmarshal([]byte(`[{"id":42},{"id":"c0ffee00-0000-0000-0000-000000000001"}]`), &exclusions); err != nil {
+		t.Fatal(err)
+	}
+	if exclusions[0].ID != "42" || exclusions[1].ID != "c0ffee00-0000-0000-0000-000000000001" {
+		t.Errorf("got IDs %q and %q", exclusions[0].ID, exclusions[1].ID)
+	}
+	if err := json.Unmarshal([]byte(`[{"id":true}]`), &exclusions); err == nil {
+		t.Error("got no error for a boolean ID")
+	}
+}
+
+func TestComplianceExclusionServiceGet(t *testing.T) {
+	api := newFakeDome9API(t)
+	config, err := dome9.NewConfig("access-id", "secret", api.server.URL+"/v2/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	service := compliance_exclusion.New(config)
+
+	created, _, err := service.Create(&compliance_exclusion.ComplianceExclusion{RulesetId: 1, Comment: "created"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, _, err := service.Get(string(created.ID))
+	if err != nil || got.Comment != "created" {
+		t.Fatalf("got exclusion %+v and error %v", got, err)
+	}
+
+	if _, err := service.Delete(string(created.ID)); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := service.Get(string(created.ID)); !isNotFoundError(err) {
+		t.Errorf("got error %v for a deleted exclusion, want a not found error", err)
+	}
+}
@@ -1,6 +1,10 @@
 package dome9
 
 import (
+	"context"
+	"net/http"
+	"time"
+
 	"github.com/dome9/dome9-sdk-go/dome9"
 	"github.com/dome9/dome9-sdk-go/services/admissioncontrol/admission_policy"
 	"github.com/dome9/dome9-sdk-go/services/assessment"
@@ -16,6 +20,8 @@ import (
 	"github.com/dome9/dome9-sdk-go/services/cloudaccounts/oci"
 	"github.com/dome9/dome9-sdk-go/services/cloudsecuritygroup/securitygroupaws"
 	"github.com/dome9/dome9-sdk-go/services/cloudsecuritygroup/securitygroupazure"
+	"github.com/dome9/dome9-sdk-go/services/compliance/compliance_exclusion"
+	"github.com/dome9/dome9-sdk-go/services/compliance/compliance_remediation"
 	"github.com/dome9/dome9-sdk-go/services/compliance/continuous_compliance_notification"
 	"github.com/dome9/dome9-sdk-go/services/compliance/continuous_compliance_policy"
 	"github.com/dome9/dome9-sdk-go/services/imageassurance/imageassurance_policy"
@@ -65,12 +71,28 @@ type Client struct {
 	awsOrganizationOnboarding        aws_org.Service
 	azureOrganizationOnboarding      azure_org.Service
 	awpAzureOnboarding               awp_azure_onboarding.Service
+	complianceExclusion              compliance_exclusion.Service
+	complianceRemediation            compliance_remediation.Service
+
+	// stopContext is cancelled when Terraform stops the provider
+	stopContext context.Context
 }
 
 type Config struct {
-	AccessID  string
-	SecretKey string
-	BaseURL   string
+	AccessID    string
+	SecretKey   string
+	BaseURL     string
+	RetryPolicy retryPolicy
+	// RequestsPerSecond limits the rate of API calls of all services, 0 disables the limit
+	RequestsPerSecond float64
+	Burst             int
+	// StopContext is cancelled when Terraform stops the provider, e.g. on Ctrl-C
+	StopContext context.Context
+	// SkipCredentialsValidation disables the API call checking the keys when the client is created
+	SkipCredentialsValidation bool
+	// HTTPClient replaces the HTTP client of the SDK, e.g. to record or replay the API calls in tests.
+	// Its transport is wrapped by the rate limit and retry transports, it is not modified.
+	HTTPClient *http.Client
 }
 
 func (c *Config) Client() (*Client, error) {
@@ -78,6 +100,25 @@ func (c *Config) Client() (*Client, error) {
 	if err != nil {
 		return nil, err
 	}
+	redactProviderLogs()
+	redactSDKLogger(config)
+	if c.HTTPClient != nil {
+		httpClient := *c.HTTPClient
+		config.HTTPClient = &httpClient
+	}
+	if c.RequestsPerSecond > 0 {
+		config.HTTPClient.Transport = &rateLimitTransport{transport: config.HTTPClient.Transport, limiter: newRateLimiter(c.RequestsPerSecond, c.Burst)}
+	}
+	config.HTTPClient.Transport = newRetryTransport(config.HTTPClient.Transport, c.RetryPolicy)
+	if !c.SkipCredentialsValidation {
+		ctx := c.StopContext
+		if ctx == nil {
+			ctx = context.Background()
+		}
+		if err := validateCredentials(ctx, config); err != nil {
+			return nil, err
+		}
+	}
 
 	client := &Client{
 		iplist:                           *iplist.New(config),
@@ -107,8 +148,22 @@ func (c *Config) Client() (*Client, error) {
 		awsOrganizationOnboarding:        *aws_org.New(config),
 		awpAzureOnboarding:               *awp_azure_onboarding.New(config),
 		azureOrganizationOnboarding:      *azure_org.New(config),
+		complianceExclusion:              *compliance_exclusion.New(config),
+		complianceRemediation:            *compliance_remediation.New(config),
+		stopContext:                      c.StopContext,
 	}
 
 	log.Println("[INFO] initialized Dome9 client")
 	return client, nil
 }
+
+// requestContext returns the context of the API calls of an operation. It is cancelled at timeout, or when Terraform
+// stops the provider, e.g. on Ctrl-C, which aborts the in-flight calls and the retry waits right away.
+func (c *Client) requestContext(timeout time.Duration) (context.Context, context.CancelFunc) {
+	ctx := c.stopContext
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	return context.WithTimeout(ctx, timeout)
+}
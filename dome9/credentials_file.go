@@ -0,0 +1,116 @@
+package dome9
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/terraform-providers/terraform-provider-dome9/dome9/common/providerconst"
+)
+
+// Keys a profile of the shared credentials file may set, named after the matching provider arguments
+var credentialsFileKeys = []string{
+	providerconst.ProviderAccessID,
+	providerconst.ProviderSecretKey,
+	providerconst.ProviderRegion,
+	providerconst.ProviderBaseURL,
+}
+
+// parseCredentialsFile parses an INI file made of [profile] sections holding key = value lines.
+// Blank lines and lines starting with # or ; are ignored.
+func parseCredentialsFile(r io.Reader) (map[string]map[string]string, error) {
+	profiles := make(map[string]map[string]string)
+	var profile map[string]string
+	scanner := bufio.NewScanner(r)
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case line == "", strings.HasPrefix(line, "#"), strings.HasPrefix(line, ";"):
+			continue
+		case strings.HasPrefix(line, "["):
+			if !strings.HasSuffix(line, "]") || strings.TrimSpace(line[1:len(line)-1]) == "" {
+				return nil, fmt.Errorf("line %d: invalid profile header %q", lineNumber, line)
+			}
+			name := strings.TrimSpace(line[1 : len(line)-1])
+			if profiles[name] == nil {
+				profiles[name] = make(map[string]string)
+			}
+			profile = profiles[name]
+		default:
+			separator := strings.Index(line, "=")
+			if separator < 1 {
+				return nil, fmt.Errorf("line %d: expected key = value", lineNumber)
+			}
+			if profile == nil {
+				return nil, fmt.Errorf("line %d: key outside of a [profile] section", lineNumber)
+			}
+			key := strings.ToLower(strings.TrimSpace(line[:separator]))
+			value := strings.Trim(strings.TrimSpace(line[separator+1:]), `"'`)
+			if !isCredentialsFileKey(key) {
+				return nil, fmt.Errorf("line %d: unknown key %q, expected one of %s", lineNumber, key, strings.Join(credentialsFileKeys, ", "))
+			}
+			profile[key] = value
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return profiles, nil
+}
+
+func isCredentialsFileKey(key string) bool {
+	for _, k := range credentialsFileKeys {
+		if k == key {
+			return true
+		}
+	}
+
+	return false
+}
+
+// loadCredentialsProfile returns the profile settings from the shared credentials file. A missing file is
+// only an error when the profile was set explicitly, the default profile is optional.
+func loadCredentialsProfile(d *schema.ResourceData) (map[string]string, error) {
+	name := d.Get(providerconst.ProviderProfile).(string)
+	path := d.Get(providerconst.ProviderSharedCredentialsFile).(string)
+	explicit := name != ""
+	if !explicit {
+		name = providerconst.DefaultProfile
+	}
+
+	if strings.HasPrefix(path, "~/") {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve the shared credentials file %s: %w", path, err)
+		}
+		path = filepath.Join(home, path[2:])
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) && !explicit {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read the shared credentials file: %w", err)
+	}
+	defer file.Close()
+
+	profiles, err := parseCredentialsFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the shared credentials file %s: %w", path, err)
+	}
+
+	profile, ok := profiles[name]
+	if !ok && explicit {
+		return nil, fmt.Errorf("profile %q not found in the shared credentials file %s", name, path)
+	}
+
+	return profile, nil
+}
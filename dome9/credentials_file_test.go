@@ -0,0 +1,125 @@
+package dome9
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/terraform-providers/terraform-provider-dome9/dome9/common/providerconst"
+)
+
+const testCredentialsFile = `
+# CloudGuard tenants
+[default]
+dome9_access_id  = default-id
+dome9_secret_key = default-secret
+
+[eu-tenant]
+dome9_access_id  = "eu-id"
+dome9_secret_key = eu-secret
+region           = eu1
+
+; private endpoint
+[test]
+dome9_access_id  = test-id
+dome9_secret_key = test-secret
+base_url         = https://dome9.example.com/v2/
+`
+
+func TestParseCredentialsFile(t *testing.T) {
+	profiles, err := parseCredentialsFile(strings.NewReader(testCredentialsFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(profiles) != 3 {
+		t.Errorf("got %d profiles, want 3", len(profiles))
+	}
+	if got := profiles["eu-tenant"][providerconst.ProviderAccessID]; got != "eu-id" {
+		t.Errorf("got access id %q, want the unquoted eu-id", got)
+	}
+	if got := profiles["test"][providerconst.ProviderBaseURL]; got != "https://dome9.example.com/v2/" {
+		t.Errorf("got base url %q", got)
+	}
+
+	for _, invalid := range []string{
+		"dome9_access_id = outside",
+		"[default\ndome9_access_id = id",
+		"[]",
+		"[default]\nno separator",
+		"[default]\naccess_key = id",
+	} {
+		if _, err := parseCredentialsFile(strings.NewReader(invalid)); err == nil {
+			t.Errorf("parsing %q succeeded, want an error", invalid)
+		}
+	}
+}
+
+func TestProviderCredentialsProfile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "credentials")
+	if err := ioutil.WriteFile(path, []byte(testCredentialsFile), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	provider := Provider().(*schema.Provider)
+	cases := []struct {
+		name       string
+		raw        map[string]interface{}
+		wantID     string
+		wantSecret string
+		wantURL    string
+	}{
+		{"default profile", map[string]interface{}{}, "default-id", "default-secret", ""},
+		{"profile region", map[string]interface{}{providerconst.ProviderProfile: "eu-tenant"}, "eu-id", "eu-secret", "https://api.eu1.dome9.com/v2/"},
+		{"profile base url", map[string]interface{}{providerconst.ProviderProfile: "test"}, "test-id", "test-secret", "https://dome9.example.com/v2/"},
+		{"provider block takes precedence", map[string]interface{}{
+			providerconst.ProviderProfile:  "eu-tenant",
+			providerconst.ProviderAccessID: "hcl-id",
+			providerconst.ProviderRegion:   "ap1",
+		}, "hcl-id", "eu-secret", "https://api.ap1.dome9.com/v2/"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.raw[providerconst.ProviderSharedCredentialsFile] = path
+			d := schema.TestResourceDataRaw(t, provider.Schema, tc.raw)
+			profile, err := loadCredentialsProfile(d)
+			if err != nil {
+				t.Fatal(err)
+			}
+			url, err := providerBaseURL(d, profile)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got := providerSetting(d, providerconst.ProviderAccessID, profile); got != tc.wantID {
+				t.Errorf("got access id %q, want %q", got, tc.wantID)
+			}
+			if got := providerSetting(d, providerconst.ProviderSecretKey, profile); got != tc.wantSecret {
+				t.Errorf("got secret key %q, want %q", got, tc.wantSecret)
+			}
+			if url != tc.wantURL {
+				t.Errorf("got base url %q, want %q", url, tc.wantURL)
+			}
+		})
+	}
+
+	for _, raw := range []map[string]interface{}{
+		{providerconst.ProviderSharedCredentialsFile: path, providerconst.ProviderProfile: "missing"},
+		{providerconst.ProviderSharedCredentialsFile: filepath.Join(t.TempDir(), "missing"), providerconst.ProviderProfile: "default"},
+	} {
+		if _, err := loadCredentialsProfile(schema.TestResourceDataRaw(t, provider.Schema, raw)); err == nil {
+			t.Errorf("loading %v succeeded, want an error", raw)
+		}
+	}
+
+	d := schema.TestResourceDataRaw(t, provider.Schema, map[string]interface{}{
+		providerconst.ProviderSharedCredentialsFile: filepath.Join(t.TempDir(), "missing"),
+	})
+	if profile, err := loadCredentialsProfile(d); err != nil || profile != nil {
+		t.Errorf("got %v, %v for a missing file and the default profile, want nothing", profile, err)
+	}
+}
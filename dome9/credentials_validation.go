@@ -0,0 +1,54 @@
+package dome9
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/dome9/dome9-sdk-go/dome9"
+	"github.com/dome9/dome9-sdk-go/dome9/client"
+
+	"github.com/terraform-providers/terraform-provider-dome9/dome9/common/providerconst"
+)
+
+// The API has no health check endpoint, listing the IP lists is the cheapest authenticated call
+const credentialsValidationPath = "iplist"
+
+// validateCredentials makes a single authenticated call with the configured keys, cancelled with ctx. The keys are
+// never sent to another data center, so rejected keys are reported with their likely causes.
+func validateCredentials(ctx context.Context, config *dome9.Config) error {
+	endpoint := config.BaseURL.String()
+	log.Printf("[INFO] Validating the credentials of access ID %s against %s", config.AccessID, endpoint)
+
+	err := credentialsValidationCall(ctx, config)
+	if err == nil {
+		return nil
+	}
+
+	var unauthorized *UnauthorizedError
+	if !errors.As(err, &unauthorized) {
+		return fmt.Errorf("failed to validate the credentials of access ID %s against %s: %w", config.AccessID, endpoint, err)
+	}
+
+	if unauthorized.StatusCode == http.StatusForbidden {
+		// the keys are valid, they only lack the permission to list IP lists
+		log.Printf("[WARN] Access ID %s is not allowed to list IP lists, skipping the rest of the credentials validation", config.AccessID)
+		return nil
+	}
+
+	if strings.Contains(strings.ToLower(unauthorized.Message), "expired") {
+		return fmt.Errorf("the credentials of access ID %s are expired, %s rejected them: %s", config.AccessID, endpoint, unauthorized.Message)
+	}
+
+	return fmt.Errorf("the credentials of access ID %s are wrong, were revoked or belong to another data center, %s rejected them: %s. "+
+		"Check the keys, or set %s to the data center of the account, one of %s",
+		config.AccessID, endpoint, unauthorized.Message, providerconst.ProviderRegion, strings.Join(providerconst.Regions, ", "))
+}
+
+func credentialsValidationCall(ctx context.Context, config *dome9.Config) error {
+	_, err := client.NewClient(config).NewRequestDoWithContext(ctx, http.MethodGet, credentialsValidationPath, nil, nil, nil)
+	return err
+}
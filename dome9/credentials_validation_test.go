@@ -0,0 +1,96 @@
+package dome9
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/dome9/dome9-sdk-go/dome9"
+)
+
+func testCredentialsServer(t *testing.T, status int, body string) (*httptest.Server, *int32) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		if r.URL.Path != "/v2/"+credentialsValidationPath {
+			t.Errorf("got validation call to %s", r.URL.Path)
+		}
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &calls
+}
+
+func TestValidateCredentials(t *testing.T) {
+	accepted, _ := testCredentialsServer(t, http.StatusOK, `[]`)
+	forbidden, _ := testCredentialsServer(t, http.StatusForbidden, `{"message":"Forbidden"}`)
+	expired, _ := testCredentialsServer(t, http.StatusUnauthorized, `{"message":"API key expired"}`)
+	rejected, _ := testCredentialsServer(t, http.StatusUnauthorized, `{"message":"Unauthorized"}`)
+	failing, _ := testCredentialsServer(t, http.StatusInternalServerError, `{"message":"Internal error"}`)
+
+	cases := []struct {
+		name     string
+		endpoint string
+		wantErr  string
+	}{
+		{"valid keys", accepted.URL, ""},
+		{"keys without permission", forbidden.URL, ""},
+		{"expired keys", expired.URL, "are expired"},
+		{"rejected keys", rejected.URL, "belong to another data center"},
+		{"server error", failing.URL, "failed to validate"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			config, err := dome9.NewConfig("access-id", testSecretValue, tc.endpoint+"/v2/")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = validateCredentials(context.Background(), config)
+			if tc.wantErr == "" {
+				if err != nil {
+					t.Fatalf("got error %v", err)
+				}
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("got no error, want %q", tc.wantErr)
+			}
+			for _, want := range []string{tc.wantErr, "access-id", tc.endpoint} {
+				if !strings.Contains(err.Error(), want) {
+					t.Errorf("error %q does not contain %q", err, want)
+				}
+			}
+			if strings.Contains(err.Error(), testSecretValue) {
+				t.Errorf("error %q contains the secret", err)
+			}
+		})
+	}
+}
+
+// Rejected keys are sent once, to the configured endpoint only
+func TestValidateCredentialsSendsKeysOnce(t *testing.T) {
+	rejected, calls := testCredentialsServer(t, http.StatusUnauthorized, `{"message":"Unauthorized"}`)
+
+	config, err := dome9.NewConfig("access-id", testSecretValue, rejected.URL+"/v2/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = validateCredentials(context.Background(), config)
+	if err == nil {
+		t.Fatal("got no error for rejected keys")
+	}
+	if got := atomic.LoadInt32(calls); got != 1 {
+		t.Errorf("keys were sent %d times", got)
+	}
+	if !strings.Contains(err.Error(), "set region to the data center of the account, one of us, eu1") {
+		t.Errorf("error %q does not name the regions", err)
+	}
+}
@@ -1,7 +1,6 @@
 package dome9
 
 import (
-	"github.com/dome9/dome9-sdk-go/dome9/client"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"log"
 )
@@ -106,7 +105,7 @@ func dataSourceAwsOrganizationOnboardingRead(d *schema.ResourceData, meta interf
 	resp, _, err := d9Client.awsOrganizationOnboarding.Get(orgId)
 
 	if err != nil {
-		if err.(*client.ErrorResponse).IsObjectNotFound() {
+		if isNotFoundError(err) {
 			log.Printf("[WARN] Removing Aws organization %s from state because it no longer exists in CloudGuard", d.Id())
 			d.SetId("")
 			return nil
@@ -14,6 +14,11 @@ func dataSourceAwsUnifiedOnboarding() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			providerconst.WaitForCompletion: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			providerconst.OnboardingId: {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -149,11 +154,22 @@ func dataSourceAwsUnifiedOnboarding() *schema.Resource {
 
 func dataSourceAwsUnifiedOnboardingReadInfo(d *schema.ResourceData, meta interface{}) error {
 	d9Client := meta.(*Client)
-	resp, _, err := d9Client.awsUnifiedOnboarding.Get(d.Get(providerconst.Id).(string))
+	id := d.Get(providerconst.Id).(string)
+	resp, _, err := d9Client.awsUnifiedOnboarding.Get(id)
 	if err != nil {
 		return err
 	}
 
+	if d.Get(providerconst.WaitForCompletion).(bool) {
+		result, err := waitForOnboardingState("AWS unified onboarding "+id, onboardingDefaultTimeout,
+			[]string{onboardingStateRegistering, onboardingStatePending}, []string{onboardingStateReady},
+			unifiedOnboardingRefreshFunc(d9Client, id))
+		if err != nil {
+			return err
+		}
+		resp = result.(*aws_unified_onboarding.UnifiedOnboardingResponse)
+	}
+
 	d.SetId(resp.OnboardingId)
 	_ = d.Set(providerconst.OnboardingId, resp.OnboardingId)
 	_ = d.Set(providerconst.InitiatedUserName, resp.InitiatedUserName)
@@ -1,7 +1,6 @@
 package dome9
 
 import (
-	"github.com/dome9/dome9-sdk-go/dome9/client"
 	"github.com/dome9/dome9-sdk-go/services/cloudaccounts/azure_org"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"log"
@@ -168,7 +167,7 @@ func dataSourceAzureOrganizationOnboardingRead(d *schema.ResourceData, meta inte
 	resp, _, err := d9Client.azureOrganizationOnboarding.Get(id)
 
 	if err != nil {
-		if err.(*client.ErrorResponse).IsObjectNotFound() {
+		if isNotFoundError(err) {
 			log.Printf("[WARN] Removing Azure organization %s from state because it no longer exists in CloudGuard", d.Id())
 			d.SetId("")
 			return nil
@@ -65,7 +65,10 @@ func dataSourceCloudAccountAlibaba() *schema.Resource {
 func dataSourceAlibabaRead(d *schema.ResourceData, meta interface{}) error {
 	d9Client := meta.(*Client)
 
-	id := d.Get("id").(string)
+	id, err := alibabaCloudAccountLookup.resolve(d9Client, d.Get("id").(string))
+	if err != nil {
+		return err
+	}
 	log.Printf("Getting data for cloud account %s with id %s\n", variable.CloudAccountAlibabaVendor, id)
 
 	alibabaCloudAccount, _, err := d9Client.cloudaccountAlibaba.Get(id)
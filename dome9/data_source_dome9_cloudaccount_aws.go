@@ -132,7 +132,10 @@ func dataSourceCloudAccountAWS() *schema.Resource {
 func dataSourceAWSRead(d *schema.ResourceData, meta interface{}) error {
 	d9Client := meta.(*Client)
 
-	id := d.Get("id").(string)
+	id, err := awsCloudAccountLookup.resolve(d9Client, d.Get("id").(string))
+	if err != nil {
+		return err
+	}
 	log.Printf("[INFO] Getting data for cloud account %s with id %s\n", variable.CloudAccountAWSVendor, id)
 
 	resp, _, err := d9Client.cloudaccountAWS.Get(cloudaccounts.QueryParameters{ID: id})
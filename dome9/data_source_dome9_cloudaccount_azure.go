@@ -62,7 +62,10 @@ func dataSourceCloudAccountAzure() *schema.Resource {
 func dataSourceAzureRead(d *schema.ResourceData, meta interface{}) error {
 	d9Client := meta.(*Client)
 
-	id := d.Get("id").(string)
+	id, err := azureCloudAccountLookup.resolve(d9Client, d.Get("id").(string))
+	if err != nil {
+		return err
+	}
 	log.Printf("Getting data for cloud account %s with id %s\n", variable.CloudAccountAzureVendor, id)
 
 	azureCloudAccount, _, err := d9Client.cloudaccountAzure.Get(cloudaccounts.QueryParameters{ID: id})
@@ -62,7 +62,10 @@ func dataSourceCloudAccountGCP() *schema.Resource {
 func dataSourceGCPRead(d *schema.ResourceData, meta interface{}) error {
 	d9Client := meta.(*Client)
 
-	id := d.Get("id").(string)
+	id, err := gcpCloudAccountLookup.resolve(d9Client, d.Get("id").(string))
+	if err != nil {
+		return err
+	}
 	log.Printf("Getting data for %s cloud account with id %s\n", variable.CloudAccountGCPVendor, id)
 
 	GCPCloudAccount, _, err := d9Client.cloudaccountGCP.Get(cloudaccounts.QueryParameters{ID: id})
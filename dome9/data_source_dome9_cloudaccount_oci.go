@@ -75,7 +75,10 @@ func dataSourceCloudAccountOCI() *schema.Resource {
 func dataSourceOciRead(d *schema.ResourceData, meta interface{}) error {
 	d9Client := meta.(*Client)
 
-	id := d.Get("id").(string)
+	id, err := ociCloudAccountLookup.resolve(d9Client, d.Get("id").(string))
+	if err != nil {
+		return err
+	}
 	log.Printf("Getting data for cloud account %s with id %s\n", variable.CloudAccountOciVendor, id)
 
 	ociCloudAccount, _, err := d9Client.cloudaccountOci.Get(id)
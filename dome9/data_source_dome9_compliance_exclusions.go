@@ -0,0 +1,172 @@
+package dome9
+
+import (
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceComplianceExclusions() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceComplianceExclusionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"ruleset_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"cloud_account_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"exclusions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ruleset_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"platform": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"comment": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"rules": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"logic_hash": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"id": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"logic_expressions": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"cloud_account_ids": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"organizational_unit_ids": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"date_range": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"from": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"to": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceComplianceExclusionsRead(d *schema.ResourceData, meta interface{}) error {
+	d9Client := meta.(*Client)
+	rulesetID, filterRuleset := d.GetOk("ruleset_id")
+	cloudAccountID, filterCloudAccount := d.GetOk("cloud_account_id")
+
+	log.Printf("[INFO] Getting compliance exclusions of ruleset %v and cloud account %v\n", rulesetID, cloudAccountID)
+	resp, _, err := d9Client.complianceExclusion.GetAll()
+	if err != nil {
+		return err
+	}
+
+	ids := make([]string, 0, len(resp))
+	exclusions := make([]interface{}, 0, len(resp))
+	for _, exclusion := range resp {
+		if filterRuleset && exclusion.RulesetId != rulesetID.(int) {
+			continue
+		}
+		if filterCloudAccount && !containsString(exclusion.CloudAccountIds, cloudAccountID.(string)) {
+			continue
+		}
+
+		ids = append(ids, string(exclusion.ID))
+		exclusions = append(exclusions, map[string]interface{}{
+			"id":                      string(exclusion.ID),
+			"ruleset_id":              exclusion.RulesetId,
+			"platform":                exclusion.Platform,
+			"comment":                 exclusion.Comment,
+			"rules":                   flattenComplianceRules(exclusion.Rules),
+			"logic_expressions":       exclusion.LogicExpressions,
+			"cloud_account_ids":       exclusion.CloudAccountIds,
+			"organizational_unit_ids": exclusion.OrganizationalUnitIds,
+			"date_range":              flattenComplianceDateRange(exclusion.DateRange),
+		})
+	}
+
+	d.SetId(complianceExclusionsID(rulesetID.(int), cloudAccountID.(string)))
+	_ = d.Set("ids", ids)
+	if err := d.Set("exclusions", exclusions); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// complianceExclusionsID identifies the list by its filters
+func complianceExclusionsID(rulesetID int, cloudAccountID string) string {
+	id := "compliance_exclusions"
+	if rulesetID != 0 {
+		id += "_" + strconv.Itoa(rulesetID)
+	}
+	if cloudAccountID != "" {
+		id += "_" + cloudAccountID
+	}
+
+	return id
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,34 @@
+package dome9
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+
+	"github.com/terraform-providers/terraform-provider-dome9/dome9/common/resourcetype"
+	"github.com/terraform-providers/terraform-provider-dome9/dome9/common/testing/method"
+	"github.com/terraform-providers/terraform-provider-dome9/dome9/common/testing/variable"
+)
+
+func TestAccDataSourceComplianceExclusionsBasic(t *testing.T) {
+	resourceTypeAndName, _, generatedName := method.GenerateRandomSourcesTypeAndName(resourcetype.ComplianceExclusion)
+	_, _, ruleSetGeneratedName := method.GenerateRandomSourcesTypeAndName(resourcetype.RuleSet)
+	dataSourceTypeAndName := "data." + resourcetype.ComplianceExclusions + "." + generatedName
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComplianceExclusionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckComplianceExclusionsConfigure(generatedName, ruleSetGeneratedName, variable.ComplianceExclusionComment),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceTypeAndName, "ids.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceTypeAndName, "ids.0", resourceTypeAndName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceTypeAndName, "exclusions.0.comment", resourceTypeAndName, "comment"),
+					resource.TestCheckResourceAttrPair(dataSourceTypeAndName, "exclusions.0.ruleset_id", resourceTypeAndName, "ruleset_id"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,78 @@
+package dome9
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/hashcode"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/terraform-providers/terraform-provider-dome9/dome9/common/gsl"
+)
+
+// dataSourceGSLEvaluation evaluates a GSL rule locally against sample entities, without calling Dome9
+func dataSourceGSLEvaluation() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGSLEvaluationRead,
+
+		Schema: map[string]*schema.Schema{
+			"logic": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateGSLRule,
+			},
+			"entities": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsJSON,
+				},
+			},
+			"results": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"passed": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"failed_indexes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
+		},
+	}
+}
+
+func dataSourceGSLEvaluationRead(d *schema.ResourceData, meta interface{}) error {
+	logic := d.Get("logic").(string)
+	entities := expandStringList(d.Get("entities").([]interface{}))
+	log.Printf("[INFO] Evaluating GSL rule %q against %d entities\n", logic, len(entities))
+
+	results := make([]string, len(entities))
+	failedIndexes := make([]int, 0)
+	for i, entity := range entities {
+		result, err := gsl.Evaluate(logic, []byte(entity))
+		if err != nil {
+			return fmt.Errorf("failed evaluating entities.%d: %s", i, err)
+		}
+		results[i] = string(result)
+		if result == gsl.Failed {
+			failedIndexes = append(failedIndexes, i)
+		}
+	}
+
+	d.SetId(strconv.Itoa(hashcode.String(logic + "\n" + strings.Join(entities, "\n"))))
+	_ = d.Set("results", results)
+	_ = d.Set("passed", len(failedIndexes) == 0)
+	_ = d.Set("failed_indexes", failedIndexes)
+
+	return nil
+}
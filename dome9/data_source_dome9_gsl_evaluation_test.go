@@ -0,0 +1,57 @@
+package dome9
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+
+	"github.com/terraform-providers/terraform-provider-dome9/dome9/common/resourcetype"
+	"github.com/terraform-providers/terraform-provider-dome9/dome9/common/testing/method"
+)
+
+func TestDataSourceGSLEvaluation(t *testing.T) {
+	api := newFakeDome9API(t)
+	_, dataSourceTypeAndName, generatedName := method.GenerateRandomSourcesTypeAndName(resourcetype.GSLEvaluation)
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: api.providers(),
+		Steps: []resource.TestStep{
+			{
+				Config:      api.config(getGSLEvaluationDataSourceHCL(generatedName, "Instance should have isPublic==false")),
+				ExpectError: regexp.MustCompile(`invalid GSL in logic at line 1, column 30: unknown operator "=="`),
+			},
+			{
+				Config: api.config(getGSLEvaluationDataSourceHCL(generatedName, "Instance where name != 'bastion' should have isPublic=false")),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceTypeAndName, "results.#", "3"),
+					resource.TestCheckResourceAttr(dataSourceTypeAndName, "results.0", "passed"),
+					resource.TestCheckResourceAttr(dataSourceTypeAndName, "results.1", "failed"),
+					resource.TestCheckResourceAttr(dataSourceTypeAndName, "results.2", "not_relevant"),
+					resource.TestCheckResourceAttr(dataSourceTypeAndName, "passed", "false"),
+					resource.TestCheckResourceAttr(dataSourceTypeAndName, "failed_indexes.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceTypeAndName, "failed_indexes.0", "1"),
+				),
+			},
+		},
+	})
+}
+
+func getGSLEvaluationDataSourceHCL(generatedName, logic string) string {
+	return fmt.Sprintf(`
+data "%s" "%s" {
+  logic    = "%s"
+  entities = [
+    jsonencode({ name = "web", isPublic = false }),
+    jsonencode({ name = "api", isPublic = true }),
+    jsonencode({ name = "bastion", isPublic = true }),
+  ]
+}
+`,
+		// data source variables
+		resourcetype.GSLEvaluation,
+		generatedName,
+		logic,
+	)
+}
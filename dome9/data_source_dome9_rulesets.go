@@ -0,0 +1,205 @@
+package dome9
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/hashcode"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/terraform-providers/terraform-provider-dome9/dome9/common/providerconst"
+)
+
+func dataSourceRuleSets() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceRuleSetsRead,
+
+		Schema: map[string]*schema.Schema{
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"cloud_vendor": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(providerconst.CloudVendors, false),
+			},
+			"system_bundle": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"is_template": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"min_feature_tier": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(providerconst.FeatureTiers, true),
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"rulesets": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"cloud_vendor": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"language": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"system_bundle": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"is_template": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"hide_in_compliance": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"min_feature_tier": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"version": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"rules_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"account_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"created_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"updated_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceRuleSetsRead(d *schema.ResourceData, meta interface{}) error {
+	d9Client := meta.(*Client)
+	var nameRegex *regexp.Regexp
+	if v, ok := d.GetOk("name_regex"); ok {
+		nameRegex = regexp.MustCompile(v.(string))
+	}
+	cloudVendor, filterCloudVendor := d.GetOk("cloud_vendor")
+	minFeatureTier, filterMinFeatureTier := d.GetOk("min_feature_tier")
+	// unlike GetOk, GetOkExists tells a false filter from a missing one
+	systemBundle, filterSystemBundle := d.GetOkExists("system_bundle")
+	isTemplate, filterIsTemplate := d.GetOkExists("is_template")
+
+	log.Printf("[INFO] Getting rule sets matching name %v and cloud vendor %v\n", d.Get("name_regex"), cloudVendor)
+	resp, _, err := d9Client.ruleSet.GetAccountRuleBundles()
+	if err != nil {
+		return err
+	}
+
+	ids := make([]string, 0, len(*resp))
+	ruleSets := make([]interface{}, 0, len(*resp))
+	for _, ruleSet := range *resp {
+		if nameRegex != nil && !nameRegex.MatchString(ruleSet.Name) {
+			continue
+		}
+		if filterCloudVendor && ruleSet.CloudVendor != cloudVendor.(string) {
+			continue
+		}
+		if filterMinFeatureTier && !featureTierIncludes(minFeatureTier.(string), ruleSet.MinFeatureTier) {
+			continue
+		}
+		if filterSystemBundle && ruleSet.SystemBundle != systemBundle.(bool) {
+			continue
+		}
+		if filterIsTemplate && ruleSet.IsTemplate != isTemplate.(bool) {
+			continue
+		}
+
+		ids = append(ids, strconv.Itoa(ruleSet.ID))
+		ruleSets = append(ruleSets, map[string]interface{}{
+			"id":                 strconv.Itoa(ruleSet.ID),
+			"name":               ruleSet.Name,
+			"description":        ruleSet.Description,
+			"cloud_vendor":       ruleSet.CloudVendor,
+			"language":           ruleSet.Language,
+			"system_bundle":      ruleSet.SystemBundle,
+			"is_template":        ruleSet.IsTemplate,
+			"hide_in_compliance": ruleSet.HideInCompliance,
+			"min_feature_tier":   ruleSet.MinFeatureTier,
+			"version":            ruleSet.Version,
+			"rules_count":        ruleSet.RulesCount,
+			"account_id":         strconv.Itoa(ruleSet.AccountID),
+			"created_time":       ruleSet.CreatedTime,
+			"updated_time":       ruleSet.UpdatedTime,
+		})
+	}
+
+	// the list is identified by its filters
+	d.SetId(strconv.Itoa(hashcode.String(fmt.Sprintf("rulesets_%v_%v_%v_%v_%v_%v_%v", d.Get("name_regex"), cloudVendor,
+		minFeatureTier, filterSystemBundle, systemBundle, filterIsTemplate, isTemplate))))
+	_ = d.Set("ids", ids)
+	if err := d.Set("rulesets", ruleSets); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// featureTierIncludes reports whether the rulesets of minFeatureTier are available in tier, the tiers being ordered
+// as in providerconst.FeatureTiers. A ruleset without a min feature tier is available in every tier, one with an
+// unknown tier in none.
+func featureTierIncludes(tier, minFeatureTier string) bool {
+	if minFeatureTier == "" {
+		return true
+	}
+
+	return featureTierIndex(minFeatureTier) >= 0 && featureTierIndex(minFeatureTier) <= featureTierIndex(tier)
+}
+
+// featureTierIndex returns the rank of tier in providerconst.FeatureTiers, ignoring the case, and -1 when it is unknown
+func featureTierIndex(tier string) int {
+	for i, val := range providerconst.FeatureTiers {
+		if strings.EqualFold(val, tier) {
+			return i
+		}
+	}
+
+	return -1
+}
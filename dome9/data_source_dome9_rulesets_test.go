@@ -0,0 +1,100 @@
+package dome9
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+
+	"github.com/terraform-providers/terraform-provider-dome9/dome9/common/resourcetype"
+	"github.com/terraform-providers/terraform-provider-dome9/dome9/common/testing/method"
+	"github.com/terraform-providers/terraform-provider-dome9/dome9/common/testing/variable"
+)
+
+func TestAccDataSourceRuleSetsBasic(t *testing.T) {
+	_, dataSourceTypeAndName, generatedName := method.GenerateRandomSourcesTypeAndName(resourcetype.RuleSets)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: getRuleSetsDataSourceHCL(generatedName, variable.RuleSetsSystemNameRegex, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceTypeAndName, "ids.0"),
+					resource.TestCheckResourceAttr(dataSourceTypeAndName, "rulesets.0.system_bundle", "true"),
+					resource.TestCheckResourceAttr(dataSourceTypeAndName, "rulesets.0.cloud_vendor", "aws"),
+				),
+			},
+		},
+	})
+}
+
+func TestDataSourceRuleSets(t *testing.T) {
+	api := newFakeDome9API(t)
+	resourceTypeAndName, _, generatedName := method.GenerateRandomSourcesTypeAndName(resourcetype.RuleSet)
+	_, _, otherGeneratedName := method.GenerateRandomSourcesTypeAndName(resourcetype.RuleSet)
+	dataSourceTypeAndName := "data." + resourcetype.RuleSets + "." + generatedName
+	systemDataSourceTypeAndName := "data." + resourcetype.RuleSets + "." + generatedName + "_system"
+	// the rule sets are created before being listed
+	ruleSets := getRuleSetResourceHCL(generatedName, variable.RuleSetDescription) + getRuleSetResourceHCL(otherGeneratedName, variable.RuleSetDescription)
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    api.providers(),
+		CheckDestroy: api.checkDestroyed("Compliance/Ruleset"),
+		Steps: []resource.TestStep{
+			{
+				Config: api.config(ruleSets),
+			},
+			{
+				Config: api.config(ruleSets +
+					getRuleSetsDataSourceHCL(generatedName, "^"+generatedName+"$", false) +
+					getRuleSetsDataSourceHCL(generatedName+"_system", generatedName, true)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceTypeAndName, "ids.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceTypeAndName, "ids.0", resourceTypeAndName, "id"),
+					resource.TestCheckResourceAttr(dataSourceTypeAndName, "rulesets.0.name", generatedName),
+					resource.TestCheckResourceAttr(dataSourceTypeAndName, "rulesets.0.rules_count", "1"),
+					resource.TestCheckResourceAttr(systemDataSourceTypeAndName, "ids.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func getRuleSetsDataSourceHCL(generatedName, nameRegex string, systemBundle bool) string {
+	return fmt.Sprintf(`
+data "%s" "%s" {
+  name_regex    = "%s"
+  cloud_vendor  = "aws"
+  system_bundle = %t
+}
+`,
+		// data source variables
+		resourcetype.RuleSets,
+		generatedName,
+		nameRegex,
+		systemBundle,
+	)
+}
+
+func TestFeatureTierIncludes(t *testing.T) {
+	cases := []struct {
+		tier, minFeatureTier string
+		want                 bool
+	}{
+		{"Trial", "", true},
+		{"Trial", "Trial", true},
+		{"Trial", "Advanced", false},
+		{"Basic", "Trial", true},
+		{"Basic", "Advanced", false},
+		{"advanced", "Basic", true},
+		{"Advanced", "Advanced", true},
+		{"Advanced", "Unknown", false},
+	}
+	for _, c := range cases {
+		if got := featureTierIncludes(c.tier, c.minFeatureTier); got != c.want {
+			t.Errorf("featureTierIncludes(%q, %q) = %t, want %t", c.tier, c.minFeatureTier, got, c.want)
+		}
+	}
+}
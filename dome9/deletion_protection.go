@@ -0,0 +1,37 @@
+package dome9
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// deletionProtectionSchema returns the deletion_protection argument, blocking the destroy of the resource until it is
+// turned off
+func deletionProtectionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "block the destroy of the resource, including its replacement, until deletion_protection is set to false",
+	}
+}
+
+// checkDeletionProtection fails the destroy of the resources whose deletion_protection is enabled, before any API call
+func checkDeletionProtection(d *schema.ResourceData, resourceType string) error {
+	if d.Get("deletion_protection").(bool) {
+		return fmt.Errorf("%s %s has deletion_protection enabled, set deletion_protection to false and apply before destroying or replacing it", resourceType, d.Id())
+	}
+
+	return nil
+}
+
+// setMissingDefaults sets the arguments Dome9 doesn't return to their defaults when the state has no value for them,
+// as after an import or an upgrade of the provider
+func setMissingDefaults(d *schema.ResourceData, defaults map[string]interface{}) {
+	for key, value := range defaults {
+		if _, ok := d.GetOkExists(key); !ok {
+			_ = d.Set(key, value)
+		}
+	}
+}
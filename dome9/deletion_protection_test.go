@@ -0,0 +1,92 @@
+package dome9
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func getCloudAccountAWSDeletionHCL(deletionProtection, forceDelete bool) string {
+	return fmt.Sprintf(`
+resource "dome9_cloudaccount_aws" "test" {
+  name                = "deletion"
+  deletion_protection = %t
+  force_delete        = %t
+
+  credentials {
+    type   = "RoleBased"
+    arn    = "arn:aws:iam::123456789012:role/dome9"
+    secret = "secret"
+  }
+}
+`, deletionProtection, forceDelete)
+}
+
+func TestResourceCloudAccountAWSDeletionProtection(t *testing.T) {
+	api := newFakeDome9API(t)
+	protected := getCloudAccountAWSDeletionHCL(true, false)
+	var accountID string
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: api.providers(),
+		CheckDestroy: func(s *terraform.State) error {
+			if api.requested("DELETE /v2/cloudaccounts/" + accountID + "/DeleteForce") {
+				return fmt.Errorf("account %s force deleted with force_delete = false", accountID)
+			}
+			if !api.requested("DELETE /v2/cloudaccounts/" + accountID) {
+				return fmt.Errorf("account %s not deleted", accountID)
+			}
+			return api.checkDestroyed("cloudaccounts")(s)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: api.config(protected),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dome9_cloudaccount_aws.test", "deletion_protection", "true"),
+					func(s *terraform.State) error {
+						accountID = s.RootModule().Resources["dome9_cloudaccount_aws.test"].Primary.ID
+						return nil
+					},
+				),
+			},
+			{
+				Config:      api.config(protected),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile(`dome9_cloudaccount_aws .* has deletion_protection enabled`),
+			},
+			{
+				Config: api.config(getCloudAccountAWSDeletionHCL(false, false)),
+				Check:  resource.TestCheckResourceAttr("dome9_cloudaccount_aws.test", "deletion_protection", "false"),
+			},
+			// the arguments missing from the API responses are set to their defaults on import
+			api.importStep(getCloudAccountAWSDeletionHCL(false, false), "dome9_cloudaccount_aws.test", "credentials", "force_delete"),
+		},
+	})
+}
+
+func TestResourceCloudAccountAWSForceDelete(t *testing.T) {
+	api := newFakeDome9API(t)
+	var accountID string
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: api.providers(),
+		CheckDestroy: func(s *terraform.State) error {
+			if !api.requested("DELETE /v2/cloudaccounts/" + accountID + "/DeleteForce") {
+				return fmt.Errorf("account %s not force deleted", accountID)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: api.config(getCloudAccountAWSDeletionHCL(false, true)),
+				Check: func(s *terraform.State) error {
+					accountID = s.RootModule().Resources["dome9_cloudaccount_aws.test"].Primary.ID
+					return nil
+				},
+			},
+		},
+	})
+}
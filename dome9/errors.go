@@ -0,0 +1,25 @@
+package dome9
+
+import (
+	"errors"
+
+	"github.com/dome9/dome9-sdk-go/dome9/client"
+)
+
+// The SDK client returns the failed API calls as one of these typed errors. Use errors.As with *APIError to match
+// any API error, or with one of the typed errors to match a specific failure.
+type (
+	APIError          = client.APIError
+	NotFoundError     = client.NotFoundError
+	UnauthorizedError = client.UnauthorizedError
+	ConflictError     = client.ConflictError
+	ThrottledError    = client.ThrottledError
+	ValidationError   = client.ValidationError
+)
+
+// isNotFoundError reports whether err is an API error for an object that no longer exists.
+// Read functions use it to drop missing objects from the state, any other error must be returned.
+func isNotFoundError(err error) bool {
+	var notFound *NotFoundError
+	return errors.As(err, &notFound)
+}
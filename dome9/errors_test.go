@@ -0,0 +1,128 @@
+package dome9
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/dome9/dome9-sdk-go/dome9"
+	"github.com/dome9/dome9-sdk-go/dome9/client"
+)
+
+// testAPIError returns the error of the SDK client for a response with the given status and body
+func testAPIError(t *testing.T, status int, body string, header http.Header) error {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		for key, values := range header {
+			w.Header()[key] = values
+		}
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}))
+	defer server.Close()
+
+	config, err := dome9.NewConfig("access-id", "secret", server.URL+"/v2/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = client.NewClient(config).NewRequestDo(http.MethodGet, "iplist/1", nil, nil, nil)
+	if err == nil {
+		t.Fatal("got no error from the SDK")
+	}
+
+	return err
+}
+
+func TestAPIError(t *testing.T) {
+	cases := []struct {
+		status int
+		body   string
+		is     func(error) bool
+	}{
+		{http.StatusNotFound, ``, func(err error) bool { var e *NotFoundError; return errors.As(err, &e) }},
+		{http.StatusUnauthorized, `{"Message":"Unauthorized"}`, func(err error) bool { var e *UnauthorizedError; return errors.As(err, &e) }},
+		{http.StatusForbidden, `{"Message":"Forbidden"}`, func(err error) bool { var e *UnauthorizedError; return errors.As(err, &e) }},
+		{http.StatusConflict, `{"Message":"Already exists"}`, func(err error) bool { var e *ConflictError; return errors.As(err, &e) }},
+		{http.StatusTooManyRequests, ``, func(err error) bool { var e *ThrottledError; return errors.As(err, &e) }},
+		{http.StatusBadRequest, `{"Message":"The request is invalid."}`, func(err error) bool { var e *ValidationError; return errors.As(err, &e) }},
+		{http.StatusInternalServerError, `oops`, func(err error) bool { var e *NotFoundError; return !errors.As(err, &e) }},
+	}
+
+	for _, tc := range cases {
+		t.Run(http.StatusText(tc.status), func(t *testing.T) {
+			err := fmt.Errorf("wrapped: %w", testAPIError(t, tc.status, tc.body, nil))
+			if !tc.is(err) {
+				t.Errorf("error %v has the wrong type %T", err, err)
+			}
+
+			var apiErr *APIError
+			if !errors.As(err, &apiErr) || apiErr.StatusCode != tc.status {
+				t.Errorf("error %v does not match *APIError with status %d", err, tc.status)
+			}
+			var errResp *client.ErrorResponse
+			if !errors.As(err, &errResp) {
+				t.Errorf("error %v does not unwrap to the SDK error", err)
+			}
+		})
+	}
+}
+
+func TestAPIErrorBody(t *testing.T) {
+	err := testAPIError(t, http.StatusBadRequest, `{"Message":"The request is invalid.","ModelState":{"name":["The name is required"]}}`, nil)
+	var validation *ValidationError
+	if !errors.As(err, &validation) {
+		t.Fatalf("got %T, want *ValidationError", err)
+	}
+	if validation.Message != "The request is invalid." {
+		t.Errorf("got message %q", validation.Message)
+	}
+	if got := validation.Fields["name"]; len(got) != 1 || got[0] != "The name is required" {
+		t.Errorf("got fields %v", validation.Fields)
+	}
+
+	err = testAPIError(t, http.StatusTooManyRequests, `Too Many Requests`, http.Header{"Retry-After": []string{"12"}})
+	var throttled *ThrottledError
+	if !errors.As(err, &throttled) {
+		t.Fatalf("got %T, want *ThrottledError", err)
+	}
+	if throttled.RetryAfter != 12*time.Second || throttled.Message != "Too Many Requests" {
+		t.Errorf("got retry after %s and message %q", throttled.RetryAfter, throttled.Message)
+	}
+}
+
+// Network and decoding errors used to panic the Read functions
+func TestIsNotFoundError(t *testing.T) {
+	if !isNotFoundError(testAPIError(t, http.StatusNotFound, ``, nil)) {
+		t.Error("404 is not a not found error")
+	}
+	for _, err := range []error{
+		nil,
+		errors.New("dial tcp: connection refused"),
+		fmt.Errorf("invalid character 'x' looking for beginning of value"),
+		testAPIError(t, http.StatusInternalServerError, ``, nil),
+	} {
+		if isNotFoundError(err) {
+			t.Errorf("%v is a not found error", err)
+		}
+	}
+}
+
+// The retry loop of the SDK used to read the status of the missing response of a failed call
+func TestSDKNetworkError(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	server.Close()
+
+	config, err := dome9.NewConfig("access-id", "secret", server.URL+"/v2/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := client.NewClient(config).NewRequestDoRetry(http.MethodGet, "iplist/1", nil, nil, nil, nil)
+	if err == nil || resp != nil {
+		t.Errorf("got response %v and error %v, want a network error", resp, err)
+	}
+	if isNotFoundError(err) {
+		t.Errorf("%v is a not found error", err)
+	}
+}
@@ -0,0 +1,631 @@
+package dome9
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/terraform-providers/terraform-provider-dome9/dome9/common/resourcetype"
+)
+
+// ExportConfig configures Export
+type ExportConfig struct {
+	// Dir is the directory the .tf files are written to
+	Dir string
+	// Types are the resource types to export, all the exported types when empty
+	Types []string
+	// ProviderConfig holds the arguments of the provider block, the environment variables and the shared credentials
+	// file being used as by the provider
+	ProviderConfig map[string]interface{}
+	// Log receives the progress of the export
+	Log io.Writer
+}
+
+// exportedObject is an object listed for the export, name being the base of its resource name
+type exportedObject struct {
+	id   string
+	name string
+}
+
+// exportedResource lists the objects of a resource type for the export
+type exportedResource struct {
+	resourceType string
+	list         func(c *Client) ([]exportedObject, error)
+}
+
+// rootOrganizationalUnitID is the ID of the root organizational unit, which isn't managed
+const rootOrganizationalUnitID = "00000000-0000-0000-0000-000000000000"
+
+// exportedResources are listed in the order of their files, the referenced types first
+var exportedResources = []exportedResource{
+	{resourcetype.OrganizationalUnit, func(c *Client) ([]exportedObject, error) {
+		resp, _, err := c.organizationalUnit.GetAll()
+		if err != nil {
+			return nil, err
+		}
+		var objects []exportedObject
+		for _, ou := range *resp {
+			if ou.Item.ID != rootOrganizationalUnitID {
+				objects = append(objects, exportedObject{ou.Item.ID, ou.Item.Name})
+			}
+		}
+		return objects, nil
+	}},
+	{resourcetype.Role, func(c *Client) ([]exportedObject, error) {
+		resp, _, err := c.role.GetAll()
+		if err != nil {
+			return nil, err
+		}
+		var objects []exportedObject
+		for _, role := range *resp {
+			objects = append(objects, exportedObject{strconv.Itoa(role.ID), role.Name})
+		}
+		return objects, nil
+	}},
+	{resourcetype.User, func(c *Client) ([]exportedObject, error) {
+		resp, _, err := c.users.GetAll()
+		if err != nil {
+			return nil, err
+		}
+		var objects []exportedObject
+		for _, user := range *resp {
+			objects = append(objects, exportedObject{strconv.Itoa(user.ID), user.Email})
+		}
+		return objects, nil
+	}},
+	{resourcetype.IPList, func(c *Client) ([]exportedObject, error) {
+		resp, _, err := c.iplist.GetAll()
+		if err != nil {
+			return nil, err
+		}
+		var objects []exportedObject
+		for _, ipList := range *resp {
+			objects = append(objects, exportedObject{strconv.FormatInt(ipList.Id, 10), ipList.Name})
+		}
+		return objects, nil
+	}},
+	{resourcetype.RuleSet, func(c *Client) ([]exportedObject, error) {
+		resp, _, err := c.ruleSet.GetAccountRuleBundles()
+		if err != nil {
+			return nil, err
+		}
+		var objects []exportedObject
+		for _, ruleSet := range *resp {
+			// the system rulesets are provided by Dome9
+			if !ruleSet.SystemBundle {
+				objects = append(objects, exportedObject{strconv.Itoa(ruleSet.ID), ruleSet.Name})
+			}
+		}
+		return objects, nil
+	}},
+	{resourcetype.Notification, func(c *Client) ([]exportedObject, error) {
+		resp, _, err := c.notifications.GetAll()
+		if err != nil {
+			return nil, err
+		}
+		var objects []exportedObject
+		for _, notification := range *resp {
+			objects = append(objects, exportedObject{notification.Id, notification.Name})
+		}
+		return objects, nil
+	}},
+	{resourcetype.ContinuousComplianceNotification, func(c *Client) ([]exportedObject, error) {
+		resp, _, err := c.continuousComplianceNotification.GetAll()
+		if err != nil {
+			return nil, err
+		}
+		var objects []exportedObject
+		for _, notification := range *resp {
+			objects = append(objects, exportedObject{notification.ID, notification.Name})
+		}
+		return objects, nil
+	}},
+	{resourcetype.Integration, func(c *Client) ([]exportedObject, error) {
+		resp, _, err := c.integration.GetAll()
+		if err != nil {
+			return nil, err
+		}
+		var objects []exportedObject
+		for _, integration := range *resp {
+			objects = append(objects, exportedObject{integration.Id, integration.Name})
+		}
+		return objects, nil
+	}},
+	{resourcetype.CloudAccountAWS, exportedCloudAccounts(listAWSCloudAccounts)},
+	{resourcetype.CloudAccountAzure, exportedCloudAccounts(listAzureCloudAccounts)},
+	{resourcetype.CloudAccountGCP, exportedCloudAccounts(listGCPCloudAccounts)},
+	{resourcetype.CloudAccountAlibaba, exportedCloudAccounts(listAlibabaCloudAccounts)},
+	{resourcetype.CloudAccountOCI, exportedCloudAccounts(listOCICloudAccounts)},
+	{resourcetype.ContinuousCompliancePolicy, func(c *Client) ([]exportedObject, error) {
+		resp, _, err := c.continuousCompliancePolicy.GetAll()
+		if err != nil {
+			return nil, err
+		}
+		var objects []exportedObject
+		for _, policy := range *resp {
+			objects = append(objects, exportedObject{policy.ID, fmt.Sprintf("%s_%d", policy.TargetType, policy.RulesetId)})
+		}
+		return objects, nil
+	}},
+	{resourcetype.AdmissionControlPolicy, func(c *Client) ([]exportedObject, error) {
+		resp, _, err := c.admissionControlPolicy.GetAll()
+		if err != nil {
+			return nil, err
+		}
+		var objects []exportedObject
+		for _, policy := range *resp {
+			objects = append(objects, exportedObject{policy.ID, fmt.Sprintf("%s_%d", policy.TargetType, policy.RulesetId)})
+		}
+		return objects, nil
+	}},
+	{resourcetype.ImageAssurancePolicy, func(c *Client) ([]exportedObject, error) {
+		resp, _, err := c.imageAssurancePolicy.GetAll()
+		if err != nil {
+			return nil, err
+		}
+		var objects []exportedObject
+		for _, policy := range *resp {
+			objects = append(objects, exportedObject{policy.ID, fmt.Sprintf("%s_%d", policy.TargetType, policy.RulesetId)})
+		}
+		return objects, nil
+	}},
+	{resourcetype.VulnerabilityPolicy, func(c *Client) ([]exportedObject, error) {
+		resp, _, err := c.vulnerabilityPolicy.GetAll()
+		if err != nil {
+			return nil, err
+		}
+		var objects []exportedObject
+		for _, policy := range *resp {
+			objects = append(objects, exportedObject{policy.ID, fmt.Sprintf("%s_%d", policy.TargetType, policy.RulesetId)})
+		}
+		return objects, nil
+	}},
+}
+
+// exportedCloudAccounts lists the cloud accounts of list for the export
+func exportedCloudAccounts(list cloudAccountLister) func(c *Client) ([]exportedObject, error) {
+	return func(c *Client) ([]exportedObject, error) {
+		accounts, err := list(c)
+		if err != nil {
+			return nil, err
+		}
+		var objects []exportedObject
+		for _, account := range accounts {
+			objects = append(objects, exportedObject{account.id, account.name})
+		}
+		return objects, nil
+	}
+}
+
+// cloudAccountTypes are the resource types the cloud account IDs may refer to
+var cloudAccountTypes = []string{resourcetype.CloudAccountAWS, resourcetype.CloudAccountAzure, resourcetype.CloudAccountGCP,
+	resourcetype.CloudAccountAlibaba, resourcetype.CloudAccountOCI}
+
+// exportReferences maps the names of the ID arguments, without their _id or _ids suffix, to the resource types their
+// values are replaced by references to
+var exportReferences = map[string][]string{
+	"role":                {resourcetype.Role},
+	"ruleset":             {resourcetype.RuleSet},
+	"bundle":              {resourcetype.RuleSet},
+	"notification":        {resourcetype.Notification, resourcetype.ContinuousComplianceNotification},
+	"integration":         {resourcetype.Integration},
+	"parent":              {resourcetype.OrganizationalUnit},
+	"organizational_unit": {resourcetype.OrganizationalUnit},
+	"cloud_account":       cloudAccountTypes,
+	"target":              append([]string{resourcetype.OrganizationalUnit}, cloudAccountTypes...),
+}
+
+// exportedState is an object read for the export
+type exportedState struct {
+	resourceType string
+	name         string
+	data         *schema.ResourceData
+}
+
+type exporter struct {
+	provider *schema.Provider
+	client   *Client
+	// names maps the resource types then the IDs of the exported objects to their resource names
+	names     map[string]map[string]string
+	variables *hclwrite.Body
+	warnings  []string
+}
+
+// Export writes the resources of the Dome9 account to .tf files, one per resource type, with the import blocks of
+// the resources in imports.tf and the variables of the values the API doesn't return, such as the secrets, in
+// variables.tf. The arguments holding the IDs of other exported objects refer to their resources.
+func Export(config ExportConfig) error {
+	p := Provider().(*schema.Provider)
+	if err := p.Configure(terraform.NewResourceConfigRaw(config.ProviderConfig)); err != nil {
+		return err
+	}
+	if config.Log == nil {
+		config.Log = ioutil.Discard
+	}
+
+	e := &exporter{
+		provider:  p,
+		client:    p.Meta().(*Client),
+		names:     make(map[string]map[string]string),
+		variables: hclwrite.NewEmptyFile().Body(),
+	}
+
+	resources, err := exportedResourcesOf(config.Types)
+	if err != nil {
+		return err
+	}
+
+	var states []exportedState
+	for _, r := range resources {
+		objects, err := r.list(e.client)
+		if err != nil {
+			e.warnf("failed listing %s: %s", r.resourceType, err)
+			continue
+		}
+		states = append(states, e.read(r.resourceType, objects)...)
+	}
+
+	if err := os.MkdirAll(config.Dir, 0755); err != nil {
+		return err
+	}
+	imports := hclwrite.NewEmptyFile()
+	for _, r := range resources {
+		file := hclwrite.NewEmptyFile()
+		count := 0
+		for _, state := range states {
+			if state.resourceType != r.resourceType {
+				continue
+			}
+			e.writeResource(file.Body(), state)
+			writeImport(imports.Body(), state)
+			count++
+		}
+		if count == 0 {
+			continue
+		}
+
+		path := filepath.Join(config.Dir, strings.TrimPrefix(r.resourceType, "dome9_")+".tf")
+		if err := writeHCLFile(path, file); err != nil {
+			return err
+		}
+		fmt.Fprintf(config.Log, "exported %d %s to %s\n", count, r.resourceType, path)
+	}
+
+	if len(states) > 0 {
+		if err := writeHCLFile(filepath.Join(config.Dir, "imports.tf"), imports); err != nil {
+			return err
+		}
+	}
+	if len(e.variables.Blocks()) > 0 {
+		variables := hclwrite.NewEmptyFile()
+		variables.Body().AppendUnstructuredTokens(e.variables.BuildTokens(nil))
+		if err := writeHCLFile(filepath.Join(config.Dir, "variables.tf"), variables); err != nil {
+			return err
+		}
+	}
+
+	if len(e.warnings) > 0 {
+		return fmt.Errorf("%d objects or resource types could not be exported:\n%s", len(e.warnings), strings.Join(e.warnings, "\n"))
+	}
+
+	return nil
+}
+
+// exportedResourcesOf returns the exported resources of types, in the order of exportedResources
+func exportedResourcesOf(types []string) ([]exportedResource, error) {
+	if len(types) == 0 {
+		return exportedResources, nil
+	}
+
+	selected := make(map[string]bool)
+	for _, t := range types {
+		selected[t] = true
+	}
+
+	var resources []exportedResource
+	for _, r := range exportedResources {
+		if selected[r.resourceType] {
+			resources = append(resources, r)
+			delete(selected, r.resourceType)
+		}
+	}
+	if len(selected) > 0 {
+		var supported []string
+		for _, r := range exportedResources {
+			supported = append(supported, r.resourceType)
+		}
+		return nil, fmt.Errorf("unsupported resource types %s, the supported types are %s",
+			strings.Join(sortedStrings(selected), ", "), strings.Join(supported, ", "))
+	}
+
+	return resources, nil
+}
+
+func sortedStrings(set map[string]bool) []string {
+	values := make([]string, 0, len(set))
+	for value := range set {
+		values = append(values, value)
+	}
+	sort.Strings(values)
+
+	return values
+}
+
+func (e *exporter) warnf(format string, args ...interface{}) {
+	e.warnings = append(e.warnings, fmt.Sprintf(format, args...))
+}
+
+// read imports and reads the objects as terraform import does, and names their resources
+func (e *exporter) read(resourceType string, objects []exportedObject) []exportedState {
+	r := e.provider.ResourcesMap[resourceType]
+	if e.names[resourceType] == nil {
+		e.names[resourceType] = make(map[string]string)
+	}
+	used := make(map[string]bool)
+
+	var states []exportedState
+	for _, object := range objects {
+		d := r.Data(nil)
+		d.SetId(object.id)
+		if r.Importer != nil && r.Importer.State != nil {
+			imported, err := r.Importer.State(d, e.client)
+			if err != nil || len(imported) == 0 {
+				e.warnf("failed importing %s %s: %v", resourceType, object.id, err)
+				continue
+			}
+			d = imported[0]
+		}
+		if err := r.Read(d, e.client); err != nil {
+			e.warnf("failed reading %s %s: %s", resourceType, object.id, err)
+			continue
+		}
+		if d.Id() == "" {
+			continue
+		}
+
+		name := exportName(resourceType, object)
+		for i := 2; used[name]; i++ {
+			name = fmt.Sprintf("%s_%d", exportName(resourceType, object), i)
+		}
+		used[name] = true
+		e.names[resourceType][d.Id()] = name
+		states = append(states, exportedState{resourceType: resourceType, name: name, data: d})
+	}
+
+	return states
+}
+
+var nonIdentifierChars = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// exportName returns a resource name made of the name of the object, of its ID when it has no usable name
+func exportName(resourceType string, object exportedObject) string {
+	name := strings.Trim(nonIdentifierChars.ReplaceAllString(strings.ToLower(object.name), "_"), "_")
+	if name == "" {
+		name = strings.Trim(nonIdentifierChars.ReplaceAllString(strings.ToLower(object.id), "_"), "_")
+	}
+	if name == "" || name[0] >= '0' && name[0] <= '9' {
+		name = strings.TrimPrefix(resourceType, "dome9_") + "_" + name
+	}
+
+	return name
+}
+
+func writeImport(body *hclwrite.Body, state exportedState) {
+	block := body.AppendNewBlock("import", nil)
+	block.Body().SetAttributeTraversal("to", hcl.Traversal{
+		hcl.TraverseRoot{Name: state.resourceType},
+		hcl.TraverseAttr{Name: state.name},
+	})
+	block.Body().SetAttributeValue("id", cty.StringVal(state.data.Id()))
+	body.AppendNewline()
+}
+
+func (e *exporter) writeResource(body *hclwrite.Body, state exportedState) {
+	r := e.provider.ResourcesMap[state.resourceType]
+	values := make(map[string]interface{}, len(r.Schema))
+	for key := range r.Schema {
+		values[key] = state.data.Get(key)
+	}
+
+	block := body.AppendNewBlock("resource", []string{state.resourceType, state.name})
+	e.writeBody(block.Body(), state, r.Schema, values, nil)
+	body.AppendNewline()
+}
+
+// writeBody writes the arguments set in values, path being the path of the nested block of values
+func (e *exporter) writeBody(body *hclwrite.Body, state exportedState, s map[string]*schema.Schema, values map[string]interface{}, path []string) {
+	keys := make([]string, 0, len(s))
+	for key := range s {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	// the arguments are written before the blocks
+	var blockKeys []string
+	for _, key := range keys {
+		sch := s[key]
+		if !sch.Required && !sch.Optional || sch.Deprecated != "" || sch.Removed != "" {
+			continue
+		}
+		if _, ok := sch.Elem.(*schema.Resource); ok {
+			blockKeys = append(blockKeys, key)
+			continue
+		}
+		value := values[key]
+		if set, ok := value.(*schema.Set); ok {
+			value = set.List()
+		}
+
+		empty := isEmptyValue(value)
+		switch {
+		case sch.Sensitive && !empty, sch.Required && empty && sch.Type == schema.TypeString:
+			// the secrets are never written and the values the API doesn't return are set by variables
+			body.SetAttributeTraversal(key, e.variable(state, sch, append(path, key)))
+		case sch.Required:
+			body.SetAttributeRaw(key, e.valueTokens(key, value))
+		case sch.Default != nil:
+			// a zero value differing from the default, as false for a default of true, is written
+			if value != nil && !reflect.DeepEqual(sch.Default, value) {
+				body.SetAttributeRaw(key, e.valueTokens(key, value))
+			}
+		case empty:
+		default:
+			body.SetAttributeRaw(key, e.valueTokens(key, value))
+		}
+	}
+
+	for _, key := range blockKeys {
+		value := values[key]
+		if set, ok := value.(*schema.Set); ok {
+			value = set.List()
+		}
+		items, _ := value.([]interface{})
+		for i, item := range items {
+			fields, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			block := body.AppendNewBlock(key, nil)
+			e.writeBody(block.Body(), state, s[key].Elem.(*schema.Resource).Schema, fields, append(path, key, strconv.Itoa(i)))
+		}
+	}
+}
+
+// variable declares a variable for the argument of path and returns a reference to it
+func (e *exporter) variable(state exportedState, sch *schema.Schema, path []string) hcl.Traversal {
+	name := strings.TrimPrefix(state.resourceType, "dome9_") + "_" + state.name + "_" + strings.Join(path, "_")
+
+	block := e.variables.AppendNewBlock("variable", []string{name})
+	block.Body().SetAttributeValue("description", cty.StringVal(fmt.Sprintf("%s of %s.%s", strings.Join(path, "."), state.resourceType, state.name)))
+	if sch.Type == schema.TypeString {
+		block.Body().SetAttributeTraversal("type", hcl.Traversal{hcl.TraverseRoot{Name: "string"}})
+	}
+	if sch.Sensitive {
+		block.Body().SetAttributeValue("sensitive", cty.True)
+	}
+	e.variables.AppendNewline()
+
+	return hcl.Traversal{hcl.TraverseRoot{Name: "var"}, hcl.TraverseAttr{Name: name}}
+}
+
+// valueTokens returns the tokens of an argument value, the IDs of exported objects being replaced by references
+func (e *exporter) valueTokens(key string, value interface{}) hclwrite.Tokens {
+	switch v := value.(type) {
+	case []interface{}:
+		tokens := hclwrite.Tokens{{Type: hclsyntax.TokenOBrack, Bytes: []byte("[")}}
+		for i, item := range v {
+			if i > 0 {
+				tokens = append(tokens, &hclwrite.Token{Type: hclsyntax.TokenComma, Bytes: []byte(",")})
+			}
+			tokens = append(tokens, e.valueTokens(key, item)...)
+		}
+		return append(tokens, &hclwrite.Token{Type: hclsyntax.TokenCBrack, Bytes: []byte("]")})
+	case map[string]interface{}:
+		fields := make(map[string]cty.Value, len(v))
+		for field, item := range v {
+			fields[field] = ctyValue(item)
+		}
+		return hclwrite.TokensForValue(cty.ObjectVal(fields))
+	}
+
+	if reference, ok := e.reference(key, fmt.Sprint(value)); ok {
+		return hclwrite.TokensForTraversal(reference)
+	}
+
+	return hclwrite.TokensForValue(ctyValue(value))
+}
+
+// reference returns a reference to the exported object whose ID is the value of the argument key
+func (e *exporter) reference(key, id string) (hcl.Traversal, bool) {
+	var base string
+	switch {
+	case strings.HasSuffix(key, "_ids"):
+		base = strings.TrimSuffix(key, "_ids")
+	case strings.HasSuffix(key, "_id"):
+		base = strings.TrimSuffix(key, "_id")
+	default:
+		return nil, false
+	}
+
+	var references []hcl.Traversal
+	for name, types := range exportReferences {
+		if base != name && !strings.HasSuffix(base, "_"+name) {
+			continue
+		}
+		for _, resourceType := range types {
+			if resourceName, ok := e.names[resourceType][id]; ok {
+				references = append(references, hcl.Traversal{
+					hcl.TraverseRoot{Name: resourceType},
+					hcl.TraverseAttr{Name: resourceName},
+					hcl.TraverseAttr{Name: "id"},
+				})
+			}
+		}
+	}
+
+	// an ID shared by objects of several types is kept as it is
+	if len(references) != 1 {
+		return nil, false
+	}
+
+	return references[0], true
+}
+
+func ctyValue(value interface{}) cty.Value {
+	switch v := value.(type) {
+	case string:
+		return cty.StringVal(v)
+	case int:
+		return cty.NumberIntVal(int64(v))
+	case float64:
+		return cty.NumberFloatVal(v)
+	case bool:
+		return cty.BoolVal(v)
+	}
+
+	return cty.StringVal(fmt.Sprint(value))
+}
+
+func isEmptyValue(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case int:
+		return v == 0
+	case float64:
+		return v == 0
+	case bool:
+		return !v
+	case []interface{}:
+		return len(v) == 0
+	case map[string]interface{}:
+		return len(v) == 0
+	}
+
+	return false
+}
+
+func writeHCLFile(path string, file *hclwrite.File) error {
+	if err := ioutil.WriteFile(path, append(bytes.TrimRight(hclwrite.Format(file.Bytes()), "\n"), '\n'), 0644); err != nil {
+		return fmt.Errorf("failed writing %s: %w", path, err)
+	}
+
+	return nil
+}
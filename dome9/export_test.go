@@ -0,0 +1,120 @@
+package dome9
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-dome9/dome9/common/resourcetype"
+)
+
+func TestExport(t *testing.T) {
+	api := newFakeDome9API(t)
+	parentID := api.seed("organizationalunit", fakeObject{"name": "Production", "parentId": rootOrganizationalUnitID})
+	api.seed("organizationalunit", fakeObject{"name": "Production Web", "parentId": parentID})
+	api.seed("role", fakeObject{"name": "auditors", "description": "read only", "permissions": map[string]interface{}{}})
+	api.seed("iplist", fakeObject{"name": "office", "description": "office ranges", "items": []interface{}{
+		map[string]interface{}{"ip": "10.0.0.0/16", "comment": "main"},
+	}})
+	api.seed("Compliance/Ruleset", fakeObject{"name": "AWS CIS Foundations", "cloudVendor": "aws", "systemBundle": true})
+	api.seed("Compliance/Ruleset", fakeObject{"name": "Custom Checks", "cloudVendor": "aws", "language": "en", "rules": []interface{}{
+		map[string]interface{}{"name": "vpc", "logic": "Instance should have vpc", "severity": "High", "ruleId": "C.1"},
+	}})
+
+	dir := t.TempDir()
+	var log bytes.Buffer
+	err := Export(ExportConfig{
+		Dir:            dir,
+		Types:          []string{resourcetype.RuleSet, resourcetype.IPList, resourcetype.OrganizationalUnit, resourcetype.Role},
+		ProviderConfig: api.providerConfig(),
+		Log:            &log,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	files := map[string][]string{
+		"organizational_unit.tf": {
+			`resource "dome9_organizational_unit" "production" {`,
+			`name      = "Production"`,
+			`resource "dome9_organizational_unit" "production_web" {`,
+			`parent_id = dome9_organizational_unit.production.id`,
+		},
+		"role.tf": {
+			`resource "dome9_role" "auditors" {`,
+			`description = "read only"`,
+		},
+		"iplist.tf": {
+			`resource "dome9_iplist" "office" {`,
+			`ip      = "10.0.0.0/16"`,
+		},
+		"ruleset.tf": {
+			`resource "dome9_ruleset" "custom_checks" {`,
+			`logic    = "Instance should have vpc"`,
+		},
+		"imports.tf": {
+			"to = dome9_organizational_unit.production\n",
+			"to = dome9_ruleset.custom_checks\n",
+			`id = "` + parentID + `"`,
+		},
+	}
+	for name, expected := range files {
+		content, err := ioutil.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, s := range expected {
+			if !strings.Contains(string(content), s) {
+				t.Errorf("%s doesn't contain %q:\n%s", name, s, content)
+			}
+		}
+	}
+
+	ruleSets, _ := ioutil.ReadFile(filepath.Join(dir, "ruleset.tf"))
+	if strings.Contains(string(ruleSets), "AWS CIS Foundations") {
+		t.Errorf("system ruleset exported:\n%s", ruleSets)
+	}
+	if !strings.Contains(log.String(), "exported 2 dome9_organizational_unit") {
+		t.Errorf("unexpected log:\n%s", log.String())
+	}
+}
+
+func TestExportDefaults(t *testing.T) {
+	api := newFakeDome9API(t)
+	api.seed("notification", fakeObject{"name": "silent", "alertsConsole": false})
+	api.seed("notification", fakeObject{"name": "console", "alertsConsole": true})
+
+	dir := t.TempDir()
+	err := Export(ExportConfig{
+		Dir:            dir,
+		Types:          []string{resourcetype.Notification},
+		ProviderConfig: api.providerConfig(),
+		Log:            ioutil.Discard,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	content, err := ioutil.ReadFile(filepath.Join(dir, "notification.tf"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// alerts_console defaults to true, so only the false value is written
+	if strings.Count(string(content), "alerts_console") != 1 || !strings.Contains(string(content), "alerts_console = false") {
+		t.Errorf("notification.tf doesn't set alerts_console to false only:\n%s", content)
+	}
+}
+
+func TestExportUnsupportedType(t *testing.T) {
+	api := newFakeDome9API(t)
+	err := Export(ExportConfig{
+		Dir:            t.TempDir(),
+		Types:          []string{"dome9_unknown"},
+		ProviderConfig: api.providerConfig(),
+	})
+	if err == nil || !strings.Contains(err.Error(), "unsupported resource types dome9_unknown") {
+		t.Fatalf("expected an unsupported type error, got %v", err)
+	}
+}
@@ -0,0 +1,726 @@
+package dome9
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/terraform-providers/terraform-provider-dome9/dome9/common/providerconst"
+)
+
+// fakeObject is an API object as stored by the fake API, numbers are kept as json.Number
+type fakeObject map[string]interface{}
+
+// fakeCollection is a REST collection of the fake API, e.g. "iplist" for /v2/iplist and /v2/iplist/{id}
+type fakeCollection struct {
+	path string
+	// idField is the JSON field holding the object ID, "id" when empty
+	idField string
+	// numericIDs makes the collection assign 1, 2, 3... instead of UUIDs
+	numericIDs bool
+	// create fills the fields the API computes when an object is created
+	create func(obj fakeObject)
+	// update fills the fields the API computes when an object is updated
+	update func(obj fakeObject)
+	// render shapes a stored object into the API response, the object itself when nil
+	render func(obj fakeObject) interface{}
+	// matchFields find the object of the PUT calls without ID, as the policies replaced by their target and ruleset
+	matchFields []string
+	// actions handle the calls to sub-resources whose body is not merged as is, keyed by the path after the
+	// object ID, as "runtimeProtection/enable", or after the collection when the body names the object, as "name"
+	actions map[string]fakeAction
+
+	objects map[string]fakeObject
+	// seeded are the IDs of the objects existing before the test, ignored by checkDestroyed
+	seeded map[string]bool
+}
+
+func (c *fakeCollection) store(api *fakeAPI, obj fakeObject) {
+	api.lastID++
+	if c.numericIDs {
+		obj[c.id()] = json.Number(fmt.Sprint(api.lastID))
+	} else {
+		obj[c.id()] = fmt.Sprintf("00000000-0000-0000-0000-%012d", api.lastID)
+	}
+	if c.create != nil {
+		c.create(obj)
+	}
+	c.objects[fmt.Sprint(obj[c.id()])] = obj
+}
+
+// fakeAction changes an object through a sub-resource, such as PUT /v2/cloudaccounts/name
+type fakeAction struct {
+	// idField is the body field naming the object, empty when the object ID is part of the path
+	idField string
+	// creates makes the call create the object of the ID in the path, as the AWP onboardings enabled on a cloud account
+	creates bool
+	apply   func(obj fakeObject, fields map[string]interface{})
+}
+
+func (c *fakeCollection) id() string {
+	if c.idField == "" {
+		return "id"
+	}
+
+	return c.idField
+}
+
+// action returns the action handling a call to segments and the ID of its object
+func (c *fakeCollection) action(segments []string, body interface{}) (fakeAction, string, bool) {
+	if len(segments) > 1 {
+		if action, ok := c.actions[strings.Join(segments[1:], "/")]; ok && action.idField == "" {
+			return action, segments[0], true
+		}
+	}
+	if action, ok := c.actions[strings.Join(segments, "/")]; ok && action.idField != "" {
+		fields, _ := body.(map[string]interface{})
+		return action, fmt.Sprint(fields[action.idField]), true
+	}
+
+	return fakeAction{}, "", false
+}
+
+// find returns the object of a PUT call without ID, by the ID in the body or by the matchFields
+func (c *fakeCollection) find(fields map[string]interface{}) (fakeObject, bool) {
+	if len(c.matchFields) == 0 {
+		obj, ok := c.objects[fmt.Sprint(fields[c.id()])]
+		return obj, ok
+	}
+
+	for _, key := range sortedKeys(c.objects) {
+		obj, matches := c.objects[key], true
+		for _, field := range c.matchFields {
+			matches = matches && fmt.Sprint(obj[field]) == fmt.Sprint(fields[field])
+		}
+		if matches {
+			return obj, true
+		}
+	}
+
+	return nil, false
+}
+
+func (c *fakeCollection) response(obj fakeObject) interface{} {
+	if c.render == nil {
+		return obj
+	}
+
+	return c.render(obj)
+}
+
+// fakeAPI is an in-process Dome9 API keeping its objects in memory. Objects created through POST are
+// returned by GET, merged with the body of PUT and PATCH calls, including the calls to sub-resources
+// such as /v2/AzureCloudAccount/{id}/AccountName, and removed by DELETE.
+type fakeAPI struct {
+	server *httptest.Server
+
+	mu          sync.Mutex
+	collections []*fakeCollection
+	lastID      int
+	// requests are the "METHOD /path" of the calls received, in order
+	requests []string
+}
+
+func newFakeAPI(t *testing.T, collections ...*fakeCollection) *fakeAPI {
+	api := &fakeAPI{collections: collections}
+	for _, c := range collections {
+		c.objects = make(map[string]fakeObject)
+		c.seeded = make(map[string]bool)
+	}
+	// longest paths first, so that kubernetes/account wins over a kubernetes collection
+	sort.Slice(api.collections, func(i, j int) bool {
+		return len(api.collections[i].path) > len(api.collections[j].path)
+	})
+
+	api.server = httptest.NewServer(http.HandlerFunc(api.serveHTTP))
+	t.Cleanup(api.server.Close)
+
+	return api
+}
+
+// providers returns a provider factory configured against the fake API
+func (api *fakeAPI) providers() map[string]terraform.ResourceProvider {
+	return map[string]terraform.ResourceProvider{"dome9": Provider()}
+}
+
+// config prepends the provider block pointing at the fake API to the resources configuration
+func (api *fakeAPI) config(resources string) string {
+	return fmt.Sprintf(`
+provider "dome9" {
+  dome9_access_id             = "fake-access-id"
+  dome9_secret_key            = "fake-secret-key"
+  base_url                    = "%s/v2/"
+  skip_credentials_validation = true
+  requests_per_second         = 0
+  max_retries                 = 0
+}
+%s`, api.server.URL, resources)
+}
+
+// providerConfig returns the arguments of the provider block of config, as the ones of the export
+func (api *fakeAPI) providerConfig() map[string]interface{} {
+	return map[string]interface{}{
+		"dome9_access_id":             "fake-access-id",
+		"dome9_secret_key":            "fake-secret-key",
+		"base_url":                    api.server.URL + "/v2/",
+		"skip_credentials_validation": true,
+		"requests_per_second":         0,
+		"max_retries":                 0,
+	}
+}
+
+// importStep imports resourceName from the fake API and compares it with the state, ignoring the
+// attributes the API never returns
+func (api *fakeAPI) importStep(resources, resourceName string, ignore ...string) resource.TestStep {
+	return resource.TestStep{
+		Config:                  api.config(resources),
+		ResourceName:            resourceName,
+		ImportState:             true,
+		ImportStateVerify:       true,
+		ImportStateVerifyIgnore: ignore,
+	}
+}
+
+// checkDestroyed fails when objects are left in the collection of path
+func (api *fakeAPI) checkDestroyed(path string) func(*terraform.State) error {
+	return func(*terraform.State) error {
+		api.mu.Lock()
+		defer api.mu.Unlock()
+
+		for _, c := range api.collections {
+			if strings.EqualFold(c.path, path) && len(c.objects) > len(c.seeded) {
+				return fmt.Errorf("%d objects left in %s", len(c.objects)-len(c.seeded), path)
+			}
+		}
+
+		return nil
+	}
+}
+
+// seed stores an object existing before the test, such as a system ruleset, and returns its ID
+func (api *fakeAPI) seed(path string, obj fakeObject) string {
+	c := api.collection(path)
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	c.store(api, obj)
+	id := fmt.Sprint(obj[c.id()])
+	c.seeded[id] = true
+
+	return id
+}
+
+// edit changes a stored object, as if it was changed out of Terraform
+func (api *fakeAPI) edit(path, id string, edit func(obj fakeObject)) {
+	c := api.collection(path)
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	edit(c.objects[id])
+	if c.update != nil {
+		c.update(c.objects[id])
+	}
+}
+
+func (api *fakeAPI) collection(path string) *fakeCollection {
+	for _, c := range api.collections {
+		if strings.EqualFold(c.path, path) {
+			return c
+		}
+	}
+
+	panic("no fake collection " + path)
+}
+
+// requested reports whether the fake API received a call, request being "METHOD /path"
+func (api *fakeAPI) requested(request string) bool {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	for _, r := range api.requests {
+		if strings.EqualFold(r, request) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (api *fakeAPI) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	api.requests = append(api.requests, r.Method+" "+r.URL.Path)
+	path := strings.Trim(strings.TrimPrefix(r.URL.Path, "/v2"), "/")
+	for _, c := range api.collections {
+		if strings.EqualFold(path, c.path) || strings.HasPrefix(strings.ToLower(path), strings.ToLower(c.path)+"/") {
+			var segments []string
+			if rest := strings.Trim(path[len(c.path):], "/"); rest != "" {
+				segments = strings.Split(rest, "/")
+			}
+			api.serveCollection(w, r, c, segments)
+			return
+		}
+	}
+
+	fakeAPIError(w, http.StatusNotFound, "no fake collection for "+r.URL.Path)
+}
+
+func (api *fakeAPI) serveCollection(w http.ResponseWriter, r *http.Request, c *fakeCollection, segments []string) {
+	var body interface{}
+	if r.Body != nil {
+		decoder := json.NewDecoder(r.Body)
+		decoder.UseNumber()
+		if err := decoder.Decode(&body); err != nil && err.Error() != "EOF" {
+			fakeAPIError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+	}
+
+	id := ""
+	if len(segments) > 0 {
+		id = segments[0]
+	}
+	if id == "" {
+		for key, values := range r.URL.Query() {
+			if strings.EqualFold(key, "id") && len(values) > 0 {
+				id = values[0]
+			}
+		}
+	}
+
+	if action, actionID, ok := c.action(segments, body); ok {
+		obj, found := c.objects[actionID]
+		if !found && action.creates {
+			obj, found = fakeObject{c.id(): actionID}, true
+			if c.create != nil {
+				c.create(obj)
+			}
+			c.objects[actionID] = obj
+		}
+		if !found {
+			fakeAPIError(w, http.StatusNotFound, fmt.Sprintf("%s %s not found", c.path, actionID))
+			return
+		}
+		fields, _ := body.(map[string]interface{})
+		if fields == nil {
+			// some sub-resources take their arguments as query parameters, as kubernetes/account/{id}/AccountName?Name=
+			fields = make(map[string]interface{})
+			for key, values := range r.URL.Query() {
+				fields[key] = values[0]
+			}
+		}
+		action.apply(obj, fields)
+		fakeAPIResponse(w, http.StatusOK, c.response(obj))
+		return
+	}
+
+	switch {
+	case r.Method == http.MethodPost && len(segments) == 0:
+		api.create(w, c, body)
+	case r.Method == http.MethodGet && id == "":
+		list := make([]interface{}, 0, len(c.objects))
+		for _, key := range sortedKeys(c.objects) {
+			list = append(list, c.response(c.objects[key]))
+		}
+		fakeAPIResponse(w, http.StatusOK, list)
+	case r.Method == http.MethodPut && id == "":
+		api.update(w, c, body)
+	default:
+		obj, ok := c.objects[id]
+		if !ok {
+			fakeAPIError(w, http.StatusNotFound, fmt.Sprintf("%s %s not found", c.path, id))
+			return
+		}
+
+		switch r.Method {
+		case http.MethodGet:
+			fakeAPIResponse(w, http.StatusOK, c.response(obj))
+		case http.MethodPut, http.MethodPatch, http.MethodPost:
+			if fields, ok := body.(map[string]interface{}); ok {
+				for key, value := range fields {
+					if key != c.id() {
+						obj[key] = value
+					}
+				}
+			}
+			if c.update != nil {
+				c.update(obj)
+			}
+			fakeAPIResponse(w, http.StatusOK, c.response(obj))
+		case http.MethodDelete:
+			delete(c.objects, id)
+			delete(c.seeded, id)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			fakeAPIError(w, http.StatusMethodNotAllowed, r.Method)
+		}
+	}
+}
+
+// create stores a single object, or every object of an array body
+func (api *fakeAPI) create(w http.ResponseWriter, c *fakeCollection, body interface{}) {
+	items, isArray := body.([]interface{})
+	if !isArray {
+		items = []interface{}{body}
+	}
+
+	var created []interface{}
+	for _, item := range items {
+		fields, ok := item.(map[string]interface{})
+		if !ok {
+			fakeAPIError(w, http.StatusBadRequest, "expected a JSON object")
+			return
+		}
+
+		obj := fakeObject(fields)
+		c.store(api, obj)
+		created = append(created, c.response(obj))
+	}
+
+	if isArray {
+		fakeAPIResponse(w, http.StatusCreated, created)
+		return
+	}
+	fakeAPIResponse(w, http.StatusCreated, created[0])
+}
+
+// update merges objects whose ID is part of the body, as in PUT /v2/notification, or which match the body
+// on the matchFields of the collection
+func (api *fakeAPI) update(w http.ResponseWriter, c *fakeCollection, body interface{}) {
+	items, isArray := body.([]interface{})
+	if !isArray {
+		items = []interface{}{body}
+	}
+
+	var updated []interface{}
+	for _, item := range items {
+		fields, _ := item.(map[string]interface{})
+		obj, ok := c.find(fields)
+		if !ok {
+			fakeAPIError(w, http.StatusNotFound, fmt.Sprintf("%s %v not found", c.path, fields))
+			return
+		}
+		for key, value := range fields {
+			obj[key] = value
+		}
+		if c.update != nil {
+			c.update(obj)
+		}
+		updated = append(updated, c.response(obj))
+	}
+
+	if isArray {
+		fakeAPIResponse(w, http.StatusOK, updated)
+		return
+	}
+	fakeAPIResponse(w, http.StatusOK, updated[0])
+}
+
+func sortedKeys(objects map[string]fakeObject) []string {
+	keys := make([]string, 0, len(objects))
+	for key := range objects {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+func fakeAPIResponse(w http.ResponseWriter, status int, v interface{}) {
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(v); err != nil {
+		fakeAPIError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_, _ = w.Write(buf.Bytes())
+}
+
+func fakeAPIError(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(map[string]string{"Message": message})
+}
+
+// Values of the fields computed by the API
+const (
+	fakeAccountID = 1
+	fakeTimestamp = "2024-01-01T00:00:00Z"
+)
+
+// fakeRuleSetUpdate computes the logic hash of the rules and the rules count of a rule set
+func fakeRuleSetUpdate(obj fakeObject) {
+	obj["updatedTime"] = fakeTimestamp
+	rules, _ := obj["rules"].([]interface{})
+	for _, item := range rules {
+		if rule, ok := item.(map[string]interface{}); ok && rule["logicHash"] == nil {
+			hash := fnv.New32a()
+			_, _ = hash.Write([]byte(fmt.Sprint(rule["logic"])))
+			rule["logicHash"] = fmt.Sprintf("%08x", hash.Sum32())
+		}
+	}
+	obj["rulesCount"] = len(rules)
+}
+
+// newFakeDome9API returns a fake API serving the collections used by the resources lifecycle tests
+func newFakeDome9API(t *testing.T) *fakeAPI {
+	// the OCI onboarding saves the tenancy first, the account created next takes its name and home region
+	ociTempData := &fakeCollection{path: "oci-cloud-account/save-temp-data", create: func(obj fakeObject) {
+		obj["vendor"] = "oci"
+		obj["creationDate"] = fakeTimestamp
+	}}
+
+	// the AWS unified onboarding is created through its stack configuration, the stack onboards the AWS cloud
+	// account of the environment and is reported as deployed
+	var api *fakeAPI
+	unifiedOnboardings := &fakeCollection{path: "AwsUnifiedOnboarding"}
+	unifiedOnboardingStackConfig := &fakeCollection{path: "AwsUnifiedOnboarding/StackConfig", create: func(obj fakeObject) {
+		api.lastID++
+		environmentID := fmt.Sprintf("00000000-0000-0000-0000-%012d", api.lastID)
+		api.collection("cloudaccounts").objects[environmentID] = fakeObject{
+			"id":                    environmentID,
+			"name":                  "unified-onboarding",
+			"vendor":                "aws",
+			"externalAccountNumber": "123456789012",
+			"creationDate":          fakeTimestamp,
+		}
+		unifiedOnboardings.objects[fmt.Sprint(obj["id"])] = fakeObject{
+			"id":                    obj["id"],
+			"onboardingId":          obj["id"],
+			"environmentId":         environmentID,
+			"environmentName":       "unified-onboarding",
+			"environmentExternalId": "123456789012",
+			"onboardingRequest":     obj,
+			"statuses": []interface{}{
+				map[string]interface{}{"module": "Permissions", "feature": "General", "status": "Active", "stackStatus": "CREATE_COMPLETE"},
+			},
+		}
+	}, render: func(obj fakeObject) interface{} {
+		return map[string]interface{}{
+			"stackName":       "CloudGuard-Onboarding",
+			"templateUrl":     "https://cloudguard.example.com/onboarding.yml",
+			"parameters":      []interface{}{map[string]interface{}{"key": "OnboardingId", "value": obj["id"]}},
+			"iamCapabilities": []string{"CAPABILITY_IAM", "CAPABILITY_NAMED_IAM", "CAPABILITY_AUTO_EXPAND"},
+		}
+	}}
+
+	api = newFakeAPI(t,
+		&fakeCollection{path: "iplist", numericIDs: true},
+		&fakeCollection{path: "role", numericIDs: true},
+		&fakeCollection{path: "cloudaccounts", create: func(obj fakeObject) {
+			delete(obj, "credentials")
+			obj["externalAccountNumber"] = "123456789012"
+			obj["creationDate"] = fakeTimestamp
+			regions := make([]interface{}, len(providerconst.AWSRegions))
+			for i, region := range providerconst.AWSRegions {
+				regions[i] = map[string]interface{}{"region": region, "name": region, "newGroupBehavior": "ReadOnly"}
+			}
+			obj["netSec"] = map[string]interface{}{"regions": regions}
+		}, actions: map[string]fakeAction{
+			"name": {idField: "cloudAccountId", apply: func(obj fakeObject, fields map[string]interface{}) {
+				obj["name"] = fields["data"]
+			}},
+			// the API never returns the credentials
+			"credentials": {idField: "cloudAccountId", apply: func(fakeObject, map[string]interface{}) {}},
+			"region-conf": {idField: "cloudAccountId", apply: func(obj fakeObject, fields map[string]interface{}) {
+				data, _ := fields["data"].(map[string]interface{})
+				netSec, _ := obj["netSec"].(map[string]interface{})
+				regions, _ := netSec["regions"].([]interface{})
+				for _, item := range regions {
+					if region, ok := item.(map[string]interface{}); ok && region["region"] == data["region"] {
+						region["newGroupBehavior"] = data["newGroupBehavior"]
+					}
+				}
+			}},
+		}},
+		&fakeCollection{path: "kubernetes/account", create: func(obj fakeObject) {
+			obj["vendor"] = "kubernetes"
+			obj["creationDate"] = fakeTimestamp
+		}, actions: fakeKubernetesFeatureActions()},
+		&fakeCollection{path: "AzureCloudAccount", create: func(obj fakeObject) {
+			delete(obj, "credentials")
+			obj["vendor"] = "azure"
+			obj["creationDate"] = fakeTimestamp
+		}},
+		&fakeCollection{path: "GoogleCloudAccount", create: func(obj fakeObject) {
+			if credentials, ok := obj["serviceAccountCredentials"].(map[string]interface{}); ok {
+				obj["projectId"] = credentials["project_id"]
+			}
+			delete(obj, "serviceAccountCredentials")
+			obj["vendor"] = "google"
+			obj["creationDate"] = fakeTimestamp
+		}},
+		&fakeCollection{path: "AlibabaCloudAccount", create: func(obj fakeObject) {
+			fakeKeepAccessKey(obj, obj["credentials"])
+			obj["alibabaAccountId"] = "5123456789012345"
+			obj["vendor"] = "alibaba"
+			obj["creationDate"] = fakeTimestamp
+		}, actions: map[string]fakeAction{
+			"Credentials": {apply: func(obj fakeObject, fields map[string]interface{}) {
+				fakeKeepAccessKey(obj, fields)
+			}},
+		}},
+		ociTempData,
+		unifiedOnboardings,
+		unifiedOnboardingStackConfig,
+		&fakeCollection{path: "oci-cloud-account", create: func(obj fakeObject) {
+			for id, tempData := range ociTempData.objects {
+				if tempData["tenancyId"] == obj["tenancyId"] {
+					obj["name"] = tempData["name"]
+					obj["homeRegion"] = tempData["homeRegion"]
+					delete(ociTempData.objects, id)
+				}
+			}
+			obj["credentials"] = map[string]interface{}{"user": obj["userOcid"], "fingerprint": "00:11:22:33", "publicKey": "public-key"}
+			delete(obj, "userOcid")
+			obj["vendor"] = "oci"
+			obj["creationDate"] = fakeTimestamp
+		}},
+		&fakeCollection{path: "CloudSecurityGroup", idField: "securityGroupId", numericIDs: true, create: func(obj fakeObject) {
+			obj["externalId"] = "sg-0123456789abcdef0"
+			obj["vpcId"] = "vpc-0123456789abcdef0"
+		}, actions: map[string]fakeAction{
+			"protection-mode": {apply: func(obj fakeObject, fields map[string]interface{}) {
+				obj["isProtected"] = fields["protectionMode"] == providerconst.FullManage
+			}},
+		}},
+		&fakeCollection{path: "AzureSecurityGroupPolicy", create: func(obj fakeObject) {
+			obj["externalSecurityGroupId"] = "/subscriptions/subscription-id/resourceGroups/" + fmt.Sprint(obj["resourceGroup"]) + "/providers/Microsoft.Network/networkSecurityGroups/" + fmt.Sprint(obj["name"])
+			obj["cloudAccountName"] = "azure-account"
+		}},
+		&fakeCollection{path: "service-account", create: func(obj fakeObject) {
+			obj["apiKeyId"] = "00000000-0000-0000-0000-00000000a91d"
+			obj["apiKeySecret"] = "secret"
+			obj["dateCreated"] = fakeTimestamp
+			obj["lastUsed"] = fakeTimestamp
+		}, actions: map[string]fakeAction{
+			"update": {idField: "id", apply: func(obj fakeObject, fields map[string]interface{}) {
+				obj["name"] = fields["name"]
+				obj["roleIds"] = fields["roleIds"]
+			}},
+		}},
+		fakeAWPOnboardings("aws"),
+		fakeAWPOnboardings("azure"),
+		&fakeCollection{path: "notification"},
+		&fakeCollection{path: "integration"},
+		&fakeCollection{path: "Compliance/ContinuousComplianceNotification"},
+		&fakeCollection{path: "Compliance/Ruleset", numericIDs: true, create: func(obj fakeObject) {
+			obj["accountId"] = fakeAccountID
+			obj["createdTime"] = fakeTimestamp
+			fakeRuleSetUpdate(obj)
+		}, update: fakeRuleSetUpdate},
+		&fakeCollection{path: "organizationalunit", create: func(obj fakeObject) {
+			obj["accountId"] = fakeAccountID
+			obj["created"] = fakeTimestamp
+			obj["updated"] = fakeTimestamp
+		}, render: func(obj fakeObject) interface{} {
+			return map[string]interface{}{"item": obj, "parentId": obj["parentId"]}
+		}},
+		&fakeCollection{path: "user", numericIDs: true, create: func(obj fakeObject) {
+			// the API returns the email as the name of the user
+			obj["name"] = obj["email"]
+			obj["lastLogin"] = fakeTimestamp
+		}},
+		&fakeCollection{path: "ContinuousCompliancePolicyV2", matchFields: fakePolicyMatchFields, create: func(obj fakeObject) {
+			obj["targetInternalId"] = obj["targetId"]
+		}},
+		&fakeCollection{path: "kubernetes/admissionControl/policy", matchFields: fakePolicyMatchFields},
+		&fakeCollection{path: "kubernetes/imageAssurance/policy", matchFields: fakePolicyMatchFields},
+		&fakeCollection{path: "vulnerability/policy", matchFields: fakePolicyMatchFields},
+		&fakeCollection{path: "aws-organization-management", create: func(obj fakeObject) {
+			delete(obj, "secret")
+			delete(obj, "apiKey")
+			obj["accountId"] = fakeAccountID
+			obj["organizationName"] = obj["awsOrganizationName"]
+			obj["externalOrganizationId"] = "o-fake"
+			obj["creationTime"] = fakeTimestamp
+			obj["onboardingConfiguration"] = map[string]interface{}{
+				"organizationRootOuId": "r-fake",
+				"mappingStrategy":      "Flat",
+				"postureManagement":    map[string]interface{}{"rulesetsIds": []interface{}{}, "onboardingMode": "Read"},
+			}
+		}, actions: map[string]fakeAction{
+			"configuration": {apply: func(obj fakeObject, fields map[string]interface{}) {
+				obj["onboardingConfiguration"] = fields
+			}},
+		}},
+		&fakeCollection{path: "azure-organization-management", create: func(obj fakeObject) {
+			delete(obj, "clientSecret")
+			obj["accountId"] = fakeAccountID
+			if obj["organizationName"] == nil {
+				obj["organizationName"] = "Tenant Root Group"
+			}
+			obj["creationTime"] = fakeTimestamp
+		}},
+		&fakeCollection{path: "Compliance/Exclusion"},
+		&fakeCollection{path: "Compliance/Remediation"},
+	)
+
+	return api
+}
+
+// fakeKeepAccessKey stores the access key of credentials, the API never returns the secret
+func fakeKeepAccessKey(obj fakeObject, credentials interface{}) {
+	fields, _ := credentials.(map[string]interface{})
+	obj["credentials"] = map[string]interface{}{"accessKey": fields["accessKey"]}
+}
+
+// fakePolicyMatchFields identify the policies, which the API updates by their target and ruleset
+var fakePolicyMatchFields = []string{"targetId", "rulesetId"}
+
+// fakeAWPOnboardings returns the AWP onboardings of the cloud accounts of provider, which are enabled by the calls
+// to the enable sub-resources and report the agentless protection as enabled at once
+func fakeAWPOnboardings(provider string) *fakeCollection {
+	enable := func(scanMode string) fakeAction {
+		return fakeAction{creates: true, apply: func(obj fakeObject, fields map[string]interface{}) {
+			obj["provider"] = provider
+			obj["agentlessProtectionEnabled"] = true
+			obj["agentlessAccountSettings"] = fields["agentlessAccountSettings"]
+			obj["scanMode"] = fields["scanMode"]
+			// the SDK names the centralized scan modes by the path instead of the body
+			if scanMode != "" {
+				obj["scanMode"] = scanMode
+			}
+		}}
+	}
+	updateSettings := fakeAction{apply: func(obj fakeObject, fields map[string]interface{}) {
+		obj["agentlessAccountSettings"] = fields
+	}}
+
+	return &fakeCollection{path: "workload/agentless/" + provider + "/accounts", idField: "cloudAccountId", actions: map[string]fakeAction{
+		"enable":                     enable(""),
+		"enableSubAccount":           enable("inAccountSub"),
+		"enableCentralizedAccount":   enable("inAccountHub"),
+		"settings":                   updateSettings,
+		"centralizedAccountSettings": updateSettings,
+	}}
+}
+
+// fakeKubernetesFeatureActions rename a kubernetes account and enable and disable its features
+func fakeKubernetesFeatureActions() map[string]fakeAction {
+	actions := map[string]fakeAction{
+		// the SDK sends the new name as the Name query parameter
+		"AccountName": {apply: func(obj fakeObject, fields map[string]interface{}) {
+			obj["name"] = fields["Name"]
+		}},
+	}
+	for _, feature := range []string{"runtimeProtection", "admissionControl", "imageAssurance", "threatIntelligence"} {
+		field := feature + "Enabled"
+		actions[feature+"/enable"] = fakeAction{apply: func(obj fakeObject, _ map[string]interface{}) { obj[field] = true }}
+		actions[feature+"/disable"] = fakeAction{apply: func(obj fakeObject, _ map[string]interface{}) { obj[field] = false }}
+	}
+
+	return actions
+}
@@ -0,0 +1,34 @@
+package dome9
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/terraform-providers/terraform-provider-dome9/dome9/common/gsl"
+)
+
+// validateGSLRule checks the syntax of the logic of a compliance rule at plan time
+func validateGSLRule(i interface{}, k string) ([]string, []error) {
+	return validateGSL(gsl.ParseRule)(i, k)
+}
+
+// validateGSLLogicExpression checks the syntax of a logic expression of an exclusion or a remediation at plan time
+func validateGSLLogicExpression(i interface{}, k string) ([]string, []error) {
+	return validateGSL(gsl.ParseLogicExpression)(i, k)
+}
+
+func validateGSL(parse func(string) (*gsl.Rule, error)) schema.SchemaValidateFunc {
+	return func(i interface{}, k string) ([]string, []error) {
+		v, ok := i.(string)
+		if !ok {
+			return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+		}
+
+		if _, err := parse(v); err != nil {
+			return nil, []error{fmt.Errorf("invalid GSL in %s at %s", k, err)}
+		}
+
+		return nil, nil
+	}
+}
@@ -0,0 +1,29 @@
+package dome9
+
+import (
+	"testing"
+)
+
+func TestValidateGSLRule(t *testing.T) {
+	if _, errs := validateGSLRule("Instance should have isPublic=false", "logic"); len(errs) != 0 {
+		t.Errorf("got errors %v for a valid rule", errs)
+	}
+
+	_, errs := validateGSLRule("Instance should have isPublic==false", "rules.0.logic")
+	want := `invalid GSL in rules.0.logic at line 1, column 30: unknown operator "=="`
+	if len(errs) != 1 || errs[0].Error() != want {
+		t.Errorf("got errors %v, want %q", errs, want)
+	}
+}
+
+func TestValidateGSLLogicExpression(t *testing.T) {
+	if _, errs := validateGSLLogicExpression("S3Bucket where name like 'logs%'", "logic_expressions.0"); len(errs) != 0 {
+		t.Errorf("got errors %v for a valid expression", errs)
+	}
+
+	_, errs := validateGSLLogicExpression("S3Bucket where (name='logs'", "logic_expressions.0")
+	want := `invalid GSL in logic_expressions.0 at line 1, column 16: unbalanced '(', missing ')'`
+	if len(errs) != 1 || errs[0].Error() != want {
+		t.Errorf("got errors %v, want %q", errs, want)
+	}
+}
@@ -0,0 +1,140 @@
+package dome9
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/dome9/dome9-sdk-go/services/awp"
+	"github.com/dome9/dome9-sdk-go/services/unifiedonboarding/aws_unified_onboarding"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+const (
+	onboardingDefaultTimeout = 20 * time.Minute
+	onboardingPollInterval   = 10 * time.Second
+)
+
+// Onboarding states reported to resource.StateChangeConf
+const (
+	onboardingStateRegistering = "registering"
+	onboardingStatePending     = "pending"
+	onboardingStateReady       = "ready"
+	onboardingStateDeleting    = "deleting"
+	onboardingStateDeleted     = "deleted"
+)
+
+func onboardingResourceTimeouts() *schema.ResourceTimeout {
+	return &schema.ResourceTimeout{
+		Create: schema.DefaultTimeout(onboardingDefaultTimeout),
+		Update: schema.DefaultTimeout(onboardingDefaultTimeout),
+		Delete: schema.DefaultTimeout(onboardingDefaultTimeout),
+	}
+}
+
+// waitForOnboardingState polls refresh every onboardingPollInterval until it reports one of the target states
+func waitForOnboardingState(description string, timeout time.Duration, pending, target []string, refresh resource.StateRefreshFunc) (interface{}, error) {
+	log.Printf("[INFO] Waiting up to %s for %s to become %s", timeout, description, strings.Join(target, " or "))
+	stateConf := &resource.StateChangeConf{
+		Pending:      pending,
+		Target:       target,
+		Refresh:      refresh,
+		Timeout:      timeout,
+		PollInterval: onboardingPollInterval,
+	}
+
+	result, err := stateConf.WaitForState()
+	if err != nil {
+		return nil, fmt.Errorf("error waiting for %s: %w", description, err)
+	}
+
+	return result, nil
+}
+
+// waitForOnboardingDeleted polls get until it returns a not found error
+func waitForOnboardingDeleted(description string, timeout time.Duration, get func() error) error {
+	_, err := waitForOnboardingState(description+" deletion", timeout, []string{onboardingStateDeleting}, []string{onboardingStateDeleted}, func() (interface{}, string, error) {
+		err := get()
+		if err == nil {
+			return description, onboardingStateDeleting, nil
+		}
+		if isNotFoundError(err) {
+			return description, onboardingStateDeleted, nil
+		}
+
+		return nil, "", err
+	})
+
+	return err
+}
+
+// onboardingRegisteredRefreshFunc reports the onboarding as registering until get stops returning not found
+func onboardingRegisteredRefreshFunc(get func() (interface{}, error), isReady func(interface{}) bool) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := get()
+		if err != nil {
+			if isNotFoundError(err) {
+				return err, onboardingStateRegistering, nil
+			}
+			return nil, "", err
+		}
+
+		if isReady != nil && !isReady(resp) {
+			return resp, onboardingStatePending, nil
+		}
+
+		return resp, onboardingStateReady, nil
+	}
+}
+
+// unifiedOnboardingRefreshFunc folds the per-blade statuses of an AWS unified onboarding into a single state,
+// failing as soon as a blade or its CloudFormation stack reports an error
+func unifiedOnboardingRefreshFunc(d9Client *Client, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, _, err := d9Client.awsUnifiedOnboarding.Get(id)
+		if err != nil {
+			if isNotFoundError(err) {
+				return err, onboardingStateRegistering, nil
+			}
+			return nil, "", err
+		}
+
+		state, err := unifiedOnboardingState(resp.Statuses)
+		return resp, state, err
+	}
+}
+
+// waitForAWPOnboardingReady polls the AWP onboarding until it reports agentless protection as enabled
+func waitForAWPOnboardingReady(description string, timeout time.Duration, get func() (*awp_onboarding.GetAWPOnboardingResponse, error)) error {
+	_, err := waitForOnboardingState(description, timeout, []string{onboardingStateRegistering, onboardingStatePending}, []string{onboardingStateReady},
+		onboardingRegisteredRefreshFunc(func() (interface{}, error) {
+			return get()
+		}, func(resp interface{}) bool {
+			return resp.(*awp_onboarding.GetAWPOnboardingResponse).AgentlessProtectionEnabled
+		}))
+
+	return err
+}
+
+func unifiedOnboardingState(statuses aws_unified_onboarding.Statuses) (string, error) {
+	state := onboardingStateReady
+	var failures []string
+	for _, status := range statuses {
+		stackStatus := strings.ToUpper(status.StackStatus)
+		switch {
+		case strings.EqualFold(status.Status, "Error"), strings.Contains(stackStatus, "FAILED"), strings.Contains(stackStatus, "ROLLBACK"):
+			failures = append(failures, fmt.Sprintf("%s/%s: status %q (%s), stack status %q (%s), remediation: %s",
+				status.Module, status.Feature, status.Status, status.StatusMessage, status.StackStatus, status.StackMessage, status.RemediationRecommendation))
+		case stackStatus == "", strings.HasSuffix(stackStatus, "_IN_PROGRESS"), strings.EqualFold(status.Status, "Pending"):
+			state = onboardingStatePending
+		}
+	}
+
+	if len(failures) > 0 {
+		return "", fmt.Errorf("onboarding failed:\n%s", strings.Join(failures, "\n"))
+	}
+
+	return state, nil
+}
@@ -0,0 +1,76 @@
+package dome9
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/dome9/dome9-sdk-go/services/unifiedonboarding/aws_unified_onboarding"
+)
+
+func TestUnifiedOnboardingState(t *testing.T) {
+	type status = struct {
+		Module                    string `json:"module"`
+		Feature                   string `json:"feature"`
+		Status                    string `json:"status"`
+		StatusMessage             string `json:"statusMessage"`
+		StackStatus               string `json:"stackStatus"`
+		StackMessage              string `json:"stackMessage"`
+		RemediationRecommendation string `json:"remediationRecommendation"`
+	}
+
+	cases := []struct {
+		name      string
+		statuses  aws_unified_onboarding.Statuses
+		wantState string
+		wantErr   string
+	}{
+		{
+			name:      "stack not deployed yet",
+			statuses:  aws_unified_onboarding.Statuses{status{Module: "Permissions", Status: "Pending"}},
+			wantState: onboardingStatePending,
+		},
+		{
+			name: "stack in progress",
+			statuses: aws_unified_onboarding.Statuses{
+				status{Module: "Permissions", Status: "Active", StackStatus: "CREATE_COMPLETE"},
+				status{Module: "Serverless Protection", Status: "Pending", StackStatus: "CREATE_IN_PROGRESS"},
+			},
+			wantState: onboardingStatePending,
+		},
+		{
+			name: "all blades complete",
+			statuses: aws_unified_onboarding.Statuses{
+				status{Module: "Permissions", Status: "Active", StackStatus: "CREATE_COMPLETE"},
+				status{Module: "Continuous Posture", Status: "Active", StackStatus: "UPDATE_COMPLETE"},
+			},
+			wantState: onboardingStateReady,
+		},
+		{
+			name: "stack rolled back",
+			statuses: aws_unified_onboarding.Statuses{
+				status{Module: "Permissions", Status: "Active", StackStatus: "CREATE_COMPLETE"},
+				status{Module: "Serverless Protection", Feature: "Workload", Status: "Error", StackStatus: "ROLLBACK_COMPLETE",
+					StackMessage: "Resource creation cancelled", RemediationRecommendation: "Delete the stack and retry"},
+			},
+			wantErr: "Delete the stack and retry",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			state, err := unifiedOnboardingState(tc.statuses)
+			if tc.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+					t.Fatalf("got error %v, want it to contain %q", err, tc.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if state != tc.wantState {
+				t.Errorf("got state %q, want %q", state, tc.wantState)
+			}
+		})
+	}
+}
@@ -1,7 +1,13 @@
 package dome9
 
 import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/hashicorp/terraform-plugin-sdk/terraform"
 
 	"github.com/terraform-providers/terraform-provider-dome9/dome9/common/providerconst"
@@ -9,26 +15,97 @@ import (
 )
 
 func Provider() terraform.ResourceProvider {
-	return &schema.Provider{
+	provider := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			providerconst.ProviderAccessID: {
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc(providerconst.ProviderAccessIDEnvVariable, nil),
 				Description: "dome9 access id",
 			},
 			providerconst.ProviderSecretKey: {
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
 				Sensitive:   true,
 				DefaultFunc: schema.EnvDefaultFunc(providerconst.ProviderSecretKeyEnvVariable, nil),
 				Description: "dome9 api secret",
 			},
 			providerconst.ProviderBaseURL: {
+				Type:          schema.TypeString,
+				Optional:      true,
+				DefaultFunc:   schema.EnvDefaultFunc(providerconst.ProviderBaseURL, nil),
+				ConflictsWith: []string{providerconst.ProviderRegion},
+				Description:   "dome9 base url",
+			},
+			providerconst.ProviderRegion: {
+				Type:          schema.TypeString,
+				Optional:      true,
+				DefaultFunc:   schema.EnvDefaultFunc(providerconst.ProviderRegionEnvVariable, nil),
+				ValidateFunc:  validation.StringInSlice(providerconst.Regions, false),
+				ConflictsWith: []string{providerconst.ProviderBaseURL},
+				Description:   "CloudGuard data center the API calls are sent to",
+			},
+			providerconst.ProviderSkipCredentialsValidation: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "skip the API call validating the credentials when the provider is configured",
+			},
+			providerconst.ProviderProfile: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc(providerconst.ProviderProfileEnvVariable, nil),
+				Description: "profile of the shared credentials file to read the credentials and the region from",
+			},
+			providerconst.ProviderSharedCredentialsFile: {
 				Type:        schema.TypeString,
 				Optional:    true,
-				DefaultFunc: schema.EnvDefaultFunc(providerconst.ProviderBaseURL, nil),
-				Description: "dome9 base url",
+				DefaultFunc: schema.EnvDefaultFunc(providerconst.ProviderSharedCredentialsFileEnvVariable, providerconst.DefaultSharedCredentialsFile),
+				Description: "path of the shared credentials file",
+			},
+			providerconst.ProviderMaxRetries: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      defaultMaxRetries,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "maximum number of times a failed API call is retried",
+			},
+			providerconst.ProviderMinBackoff: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      int(defaultMinBackoff / time.Second),
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "minimum time in seconds to wait before retrying a failed API call",
+			},
+			providerconst.ProviderMaxBackoff: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      int(defaultMaxBackoff / time.Second),
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "maximum time in seconds to wait before retrying a failed API call",
+			},
+			providerconst.ProviderRetryOnStatus: {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeInt,
+					ValidateFunc: validation.IntBetween(400, 599),
+				},
+				Description: "HTTP status codes that cause an API call to be retried, defaults to 429, 500, 502, 503 and 504",
+			},
+			providerconst.ProviderRequestsPerSec: {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				Default:      defaultRequestsPerSecond,
+				ValidateFunc: validation.FloatAtLeast(0),
+				Description:  "maximum rate of API calls shared by all resources, the default 0 disables the limit",
+			},
+			providerconst.ProviderBurst: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      defaultBurst,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "number of API calls that may be sent at once before requests_per_second applies",
 			},
 		},
 		ResourcesMap: map[string]*schema.Resource{
@@ -50,6 +127,7 @@ func Provider() terraform.ResourceProvider {
 			resourcetype.Notification:                     resourceNotification(),
 			resourcetype.Integration:                      resourceIntegration(),
 			resourcetype.RuleSet:                          resourceRuleSet(),
+			resourcetype.RuleSetRule:                      resourceRuleSetRule(),
 			resourcetype.CloudAccountAWSSecurityGroup:     resourceCloudSecurityGroupAWS(),
 			resourcetype.CloudAccountAWSSecurityGroupRule: resourceCloudSecurityGroupAWSRule(),
 			resourcetype.Role:                             resourceRole(),
@@ -65,6 +143,8 @@ func Provider() terraform.ResourceProvider {
 			resourcetype.AwpAwsOnboarding:                 resourceAwpAwsOnboarding(),
 			resourcetype.AWSOrganizationOnboarding:        resourceAwsOrganizationOnboarding(),
 			resourcetype.AzureOrganizationOnboarding:      resourceAzureOrganizationOnboarding(),
+			resourcetype.ComplianceExclusion:              resourceComplianceExclusion(),
+			resourcetype.ComplianceRemediation:            resourceComplianceRemediation(),
 			resourcetype.AwpAzureOnboarding:               resourceAwpAzureOnboarding(),
 			resourcetype.VulnerabilityPolicy:              resourceVulnerabilityPolicy(),
 		},
@@ -84,6 +164,7 @@ func Provider() terraform.ResourceProvider {
 			resourcetype.Notification:                                 dataSourceNotification(),
 			resourcetype.Integration:                                  dataSourceIntegration(),
 			resourcetype.RuleSet:                                      dataSourceRuleSet(),
+			resourcetype.RuleSets:                                     dataSourceRuleSets(),
 			resourcetype.CloudAccountAWSSecurityGroup:                 dataSourceCloudSecurityGroupAWS(),
 			resourcetype.CloudAccountAWSSecurityGroupRule:             dataSourceCloudSecurityGroupAWSRule(),
 			resourcetype.Role:                                         dataSourceRole(),
@@ -104,17 +185,102 @@ func Provider() terraform.ResourceProvider {
 			resourcetype.AwpAzureOnboarding:                           dataSourceAwpAzureOnboarding(),
 			resourcetype.VulnerabilityPolicy:                          dataSourceVulnerabilityPolicy(),
 			resourcetype.AzureOrganizationOnboarding:                  dataSourceAzureOrganizationOnboarding(),
+			resourcetype.ComplianceExclusions:                         dataSourceComplianceExclusions(),
+			resourcetype.GSLEvaluation:                                dataSourceGSLEvaluation(),
 		},
-		ConfigureFunc: providerConfigure,
 	}
+
+	provider.ConfigureFunc = func(d *schema.ResourceData) (interface{}, error) {
+		return providerConfigure(d, provider.StopContext())
+	}
+
+	return provider
 }
 
-func providerConfigure(d *schema.ResourceData) (interface{}, error) {
-	config := Config{
-		AccessID:  d.Get(providerconst.ProviderAccessID).(string),
-		SecretKey: d.Get(providerconst.ProviderSecretKey).(string),
-		BaseURL:   d.Get(providerconst.ProviderBaseURL).(string),
+func providerConfigure(d *schema.ResourceData, stopCtx context.Context) (interface{}, error) {
+	config, err := providerConfig(d, stopCtx)
+	if err != nil {
+		return nil, err
 	}
 
 	return config.Client()
 }
+
+// providerConfig builds the client configuration from the provider block, the environment and the shared credentials file
+func providerConfig(d *schema.ResourceData, stopCtx context.Context) (*Config, error) {
+	profile, err := loadCredentialsProfile(d)
+	if err != nil {
+		return nil, err
+	}
+
+	baseURL, err := providerBaseURL(d, profile)
+	if err != nil {
+		return nil, err
+	}
+
+	config := &Config{
+		AccessID:  providerSetting(d, providerconst.ProviderAccessID, profile),
+		SecretKey: providerSetting(d, providerconst.ProviderSecretKey, profile),
+		BaseURL:   baseURL,
+		RetryPolicy: retryPolicy{
+			MaxRetries: d.Get(providerconst.ProviderMaxRetries).(int),
+			MinBackoff: time.Duration(d.Get(providerconst.ProviderMinBackoff).(int)) * time.Second,
+			MaxBackoff: time.Duration(d.Get(providerconst.ProviderMaxBackoff).(int)) * time.Second,
+		},
+		RequestsPerSecond: d.Get(providerconst.ProviderRequestsPerSec).(float64),
+		Burst:             d.Get(providerconst.ProviderBurst).(int),
+		StopContext:       stopCtx,
+
+		SkipCredentialsValidation: d.Get(providerconst.ProviderSkipCredentialsValidation).(bool),
+	}
+
+	if config.AccessID == "" || config.SecretKey == "" {
+		return nil, fmt.Errorf("%s and %s must be set in the provider block, with the %s and %s environment variables or in a shared credentials file profile",
+			providerconst.ProviderAccessID, providerconst.ProviderSecretKey, providerconst.ProviderAccessIDEnvVariable, providerconst.ProviderSecretKeyEnvVariable)
+	}
+
+	if statuses, ok := d.GetOk(providerconst.ProviderRetryOnStatus); ok {
+		for _, status := range statuses.([]interface{}) {
+			config.RetryPolicy.RetryOnStatus = append(config.RetryPolicy.RetryOnStatus, status.(int))
+		}
+	}
+
+	return config, nil
+}
+
+// providerSetting returns the argument set in the provider block or the environment, falling back to the profile
+func providerSetting(d *schema.ResourceData, key string, profile map[string]string) string {
+	if value := d.Get(key).(string); value != "" {
+		return value
+	}
+
+	return profile[key]
+}
+
+// providerBaseURL returns base_url when it is set, the API URL of region otherwise. The provider block and
+// the environment take precedence over the profile. An empty URL makes the SDK use the US data center.
+func providerBaseURL(d *schema.ResourceData, profile map[string]string) (string, error) {
+	baseURL := d.Get(providerconst.ProviderBaseURL).(string)
+	region := d.Get(providerconst.ProviderRegion).(string)
+	if baseURL == "" && region == "" {
+		baseURL = profile[providerconst.ProviderBaseURL]
+		region = profile[providerconst.ProviderRegion]
+		if baseURL != "" && region != "" {
+			return "", fmt.Errorf("the shared credentials file profile sets both %s and %s, only one is allowed", providerconst.ProviderBaseURL, providerconst.ProviderRegion)
+		}
+	}
+
+	if baseURL != "" {
+		return baseURL, nil
+	}
+	if region == "" {
+		return "", nil
+	}
+
+	url, ok := providerconst.RegionBaseURLs[region]
+	if !ok {
+		return "", fmt.Errorf("unknown %s %q, expected one of %s", providerconst.ProviderRegion, region, strings.Join(providerconst.Regions, ", "))
+	}
+
+	return url, nil
+}
@@ -1,6 +1,7 @@
 package dome9
 
 import (
+	"net/http"
 	"os"
 	"testing"
 
@@ -14,12 +15,32 @@ var testAccProvider *schema.Provider
 var testAccProviders map[string]terraform.ResourceProvider
 
 func init() {
-	testAccProvider = Provider().(*schema.Provider)
+	testAccProvider = newTestAccProvider(nil)
 	testAccProviders = map[string]terraform.ResourceProvider{
 		"dome9": testAccProvider,
 	}
 }
 
+// newTestAccProvider returns a provider whose API calls go through httpClient, the default HTTP client when nil
+func newTestAccProvider(httpClient *http.Client) *schema.Provider {
+	provider := Provider().(*schema.Provider)
+	provider.ConfigureFunc = func(d *schema.ResourceData) (interface{}, error) {
+		config, err := providerConfig(d, provider.StopContext())
+		if err != nil {
+			return nil, err
+		}
+		config.HTTPClient = httpClient
+		return config.Client()
+	}
+
+	return provider
+}
+
+func TestMain(m *testing.M) {
+	setCassetteEnv()
+	os.Exit(m.Run())
+}
+
 func TestProvider(t *testing.T) {
 	if err := Provider().(*schema.Provider).InternalValidate(); err != nil {
 		t.Fatalf("err: %s", err)
@@ -33,4 +54,42 @@ func testAccPreCheck(t *testing.T) {
 	if v := os.Getenv(providerconst.ProviderSecretKeyEnvVariable); v == "" {
 		t.Fatal(providerconst.ProviderSecretKeyEnvVariable, "must be set for acceptance tests")
 	}
+	useCassette(t)
+}
+
+func TestProviderRegion(t *testing.T) {
+	provider := Provider().(*schema.Provider)
+	cases := []struct {
+		raw     map[string]interface{}
+		wantURL string
+	}{
+		{map[string]interface{}{}, ""},
+		{map[string]interface{}{providerconst.ProviderRegion: "eu1"}, "https://api.eu1.dome9.com/v2/"},
+		{map[string]interface{}{providerconst.ProviderBaseURL: "https://dome9.example.com/v2/"}, "https://dome9.example.com/v2/"},
+	}
+
+	for _, tc := range cases {
+		d := schema.TestResourceDataRaw(t, provider.Schema, tc.raw)
+		if got, err := providerBaseURL(d, nil); err != nil || got != tc.wantURL {
+			t.Errorf("providerBaseURL(%v) = %q, %v, want %q", tc.raw, got, err, tc.wantURL)
+		}
+	}
+
+	for _, region := range providerconst.Regions {
+		if providerconst.RegionBaseURLs[region] == "" {
+			t.Errorf("region %s has no API URL", region)
+		}
+	}
+
+	invalid := []map[string]interface{}{
+		{providerconst.ProviderRegion: "us-east-1"},
+		{providerconst.ProviderRegion: "eu1", providerconst.ProviderBaseURL: "https://api.eu1.dome9.com/v2/"},
+	}
+	for _, raw := range invalid {
+		raw[providerconst.ProviderAccessID] = "id"
+		raw[providerconst.ProviderSecretKey] = "secret"
+		if _, errs := provider.Validate(terraform.NewResourceConfigRaw(raw)); len(errs) == 0 {
+			t.Errorf("provider configuration %v is valid, want an error", raw)
+		}
+	}
 }
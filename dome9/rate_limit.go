@@ -0,0 +1,135 @@
+package dome9
+
+import (
+	"log"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	// the limit is opt-in, requests_per_second is 0 unless set in the provider block
+	defaultRequestsPerSecond = 0
+	defaultBurst             = 10
+
+	// the limiter never slows down below this fraction of the configured rate
+	minRateFraction = 0.1
+	// fraction of the configured rate regained after every request that was not throttled
+	rateRecoveryFraction = 0.05
+)
+
+// rateLimiter is a token bucket shared by every service of the client. It halves its rate whenever
+// the API answers 429 and recovers gradually towards the configured rate once requests go through again.
+type rateLimiter struct {
+	mu      sync.Mutex
+	maxRate float64
+	minRate float64
+	rate    float64
+	burst   float64
+	tokens  float64
+	last    time.Time
+}
+
+func newRateLimiter(requestsPerSecond float64, burst int) *rateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+
+	return &rateLimiter{
+		maxRate: requestsPerSecond,
+		minRate: requestsPerSecond * minRateFraction,
+		rate:    requestsPerSecond,
+		burst:   float64(burst),
+		tokens:  float64(burst),
+		last:    time.Now(),
+	}
+}
+
+func (l *rateLimiter) refill(now time.Time) {
+	l.tokens += now.Sub(l.last).Seconds() * l.rate
+	if l.tokens > l.burst {
+		l.tokens = l.burst
+	}
+	l.last = now
+}
+
+// reserve takes a token and returns how long the caller has to wait before using it
+func (l *rateLimiter) reserve() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.refill(time.Now())
+	l.tokens--
+	if l.tokens >= 0 {
+		return 0
+	}
+
+	return time.Duration(-l.tokens / l.rate * float64(time.Second))
+}
+
+// cancel gives back a token reserved by a request that was never sent
+func (l *rateLimiter) cancel() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.tokens++
+}
+
+func (l *rateLimiter) throttled() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.refill(time.Now())
+	l.rate /= 2
+	if l.rate < l.minRate {
+		l.rate = l.minRate
+	}
+	// drop the remaining burst so the next requests are spread at the lowered rate
+	if l.tokens > 0 {
+		l.tokens = 0
+	}
+	log.Printf("[WARN] Dome9 API is throttling requests, lowering the client rate limit to %.2f requests per second", l.rate)
+}
+
+func (l *rateLimiter) succeeded() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.rate < l.maxRate {
+		l.refill(time.Now())
+		l.rate += l.maxRate * rateRecoveryFraction
+		if l.rate > l.maxRate {
+			l.rate = l.maxRate
+		}
+	}
+}
+
+// rateLimitTransport sends every request through the shared rate limiter
+type rateLimitTransport struct {
+	transport http.RoundTripper
+	limiter   *rateLimiter
+}
+
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if wait := t.limiter.reserve(); wait > 0 {
+		timer := time.NewTimer(wait)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			t.limiter.cancel()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+
+	resp, err := t.transport.RoundTrip(req)
+	if err == nil {
+		if resp.StatusCode == http.StatusTooManyRequests {
+			t.limiter.throttled()
+		} else {
+			t.limiter.succeeded()
+		}
+	}
+
+	return resp, err
+}
@@ -0,0 +1,93 @@
+package dome9
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestRateLimiterBurst(t *testing.T) {
+	limiter := newRateLimiter(1, 3)
+	for i := 0; i < 3; i++ {
+		if wait := limiter.reserve(); wait != 0 {
+			t.Fatalf("request %d within the burst waits %s", i, wait)
+		}
+	}
+	if wait := limiter.reserve(); wait < 900*time.Millisecond || wait > time.Second {
+		t.Errorf("request after the burst waits %s, want about 1s", wait)
+	}
+}
+
+func TestRateLimiterAdapts(t *testing.T) {
+	limiter := newRateLimiter(10, 1)
+	limiter.throttled()
+	if limiter.rate != 5 {
+		t.Errorf("rate after throttling is %.2f, want 5", limiter.rate)
+	}
+	for i := 0; i < 10; i++ {
+		limiter.throttled()
+	}
+	if limiter.rate != 1 {
+		t.Errorf("rate after repeated throttling is %.2f, want the floor of 1", limiter.rate)
+	}
+	for i := 0; i < 100; i++ {
+		limiter.succeeded()
+	}
+	if limiter.rate != 10 {
+		t.Errorf("rate after recovering is %.2f, want the configured 10", limiter.rate)
+	}
+}
+
+func TestRateLimitTransport(t *testing.T) {
+	status := http.StatusTooManyRequests
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	limiter := newRateLimiter(100, 1)
+	client := &http.Client{Transport: &rateLimitTransport{transport: http.DefaultTransport, limiter: limiter}}
+
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = resp.Body.Close()
+	if limiter.rate != 50 {
+		t.Errorf("rate after a 429 is %.2f, want 50", limiter.rate)
+	}
+
+	status = http.StatusOK
+	resp, err = client.Get(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = resp.Body.Close()
+	if limiter.rate != 55 {
+		t.Errorf("rate after a success is %.2f, want 55", limiter.rate)
+	}
+}
+
+func TestRateLimitTransportCancelsWait(t *testing.T) {
+	limiter := newRateLimiter(0.001, 1)
+	limiter.reserve()
+	client := &http.Client{Transport: &rateLimitTransport{transport: http.DefaultTransport, limiter: limiter}}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://127.0.0.1:0", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	start := time.Now()
+	if _, err := client.Do(req); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("got error %v, want deadline exceeded", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("request returned after %s, want right after the deadline", elapsed)
+	}
+}
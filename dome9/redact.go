@@ -0,0 +1,101 @@
+package dome9
+
+import (
+	"io"
+	"log"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/dome9/dome9-sdk-go/dome9"
+)
+
+const redactedValue = "[REDACTED]"
+
+// Field names, lowercased and without underscores, whose values never reach the logs.
+// Every Sensitive schema attribute must normalize to one of these names.
+var redactedFieldNames = map[string]bool{
+	"secret":         true,
+	"secretkey":      true,
+	"dome9secretkey": true,
+	"accesssecret":   true,
+	"apikeysecret":   true,
+	"clientsecret":   true,
+	"clientpassword": true,
+	"password":       true,
+	"pass":           true,
+	"privatekey":     true,
+	"privatekeyid":   true,
+}
+
+var (
+	authorizationHeaderRegex = regexp.MustCompile(`(?im)^(Authorization:[ \t]*)[^\r\n]*`)
+	privateKeyBlockRegex     = regexp.MustCompile(`-----BEGIN [A-Z ]*PRIVATE KEY-----[\s\S]*?-----END [A-Z ]*PRIVATE KEY-----`)
+	jsonFieldRegex           = regexp.MustCompile(`"([A-Za-z_]+)"(\s*:\s*)"(?:[^"\\]|\\.)*"`)
+	// fields of structs printed with %+v, e.g. {Arn:arn:aws:iam::123:role/x Secret:abc}
+	structFieldRegex = regexp.MustCompile(`\b([A-Za-z_]+):([^\s{}\[\]]+)`)
+
+	redactLogsOnce sync.Once
+)
+
+func isRedactedField(name string) bool {
+	return redactedFieldNames[strings.ToLower(strings.ReplaceAll(name, "_", ""))]
+}
+
+// redact masks the Authorization header, private key blocks and the values of known secret fields in s
+func redact(s string) string {
+	s = authorizationHeaderRegex.ReplaceAllString(s, "${1}"+redactedValue)
+	s = privateKeyBlockRegex.ReplaceAllString(s, redactedValue)
+	s = jsonFieldRegex.ReplaceAllStringFunc(s, func(match string) string {
+		groups := jsonFieldRegex.FindStringSubmatch(match)
+		if !isRedactedField(groups[1]) {
+			return match
+		}
+		return `"` + groups[1] + `"` + groups[2] + `"` + redactedValue + `"`
+	})
+	s = structFieldRegex.ReplaceAllStringFunc(s, func(match string) string {
+		groups := structFieldRegex.FindStringSubmatch(match)
+		if !isRedactedField(groups[1]) {
+			return match
+		}
+		return groups[1] + ":" + redactedValue
+	})
+
+	return s
+}
+
+// redactingWriter redacts everything written to it before passing it on
+type redactingWriter struct {
+	writer io.Writer
+}
+
+func newRedactingWriter(writer io.Writer) io.Writer {
+	if _, ok := writer.(*redactingWriter); ok {
+		return writer
+	}
+
+	return &redactingWriter{writer: writer}
+}
+
+func (w *redactingWriter) Write(p []byte) (int, error) {
+	if _, err := io.WriteString(w.writer, redact(string(p))); err != nil {
+		return 0, err
+	}
+
+	return len(p), nil
+}
+
+// redactSDKLogger makes the SDK request and response dumps, enabled by DOME9_SDK_LOG, go through redact
+func redactSDKLogger(config *dome9.Config) {
+	if config.Logger != nil {
+		config.Logger = log.New(newRedactingWriter(config.Logger.Writer()), config.Logger.Prefix(), config.Logger.Flags())
+	}
+}
+
+// redactProviderLogs makes the provider's own log output go through redact. The plugin server
+// sets the standard logger output when it starts, so this has to run once the provider is configured.
+func redactProviderLogs() {
+	redactLogsOnce.Do(func() {
+		log.SetOutput(newRedactingWriter(log.Writer()))
+	})
+}
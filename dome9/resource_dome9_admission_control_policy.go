@@ -1,7 +1,6 @@
 package dome9
 
 import (
-	"github.com/dome9/dome9-sdk-go/dome9/client"
 	"github.com/dome9/dome9-sdk-go/services/admissioncontrol/admission_policy"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
@@ -78,7 +77,7 @@ func resourceAdmissionControlPolicyRead(d *schema.ResourceData, meta interface{}
 	resp, _, err := d9Client.admissionControlPolicy.Get(d.Id())
 
 	if err != nil {
-		if err.(*client.ErrorResponse).IsObjectNotFound() {
+		if isNotFoundError(err) {
 			log.Printf("[WARN] Removing admission control policy %s from state because it no longer exists in Dome9", d.Id())
 			d.SetId("")
 			return nil
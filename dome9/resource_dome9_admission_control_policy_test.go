@@ -76,6 +76,47 @@ func TestAccResourceAdmissionControlPolicyBasic(t *testing.T) {
 	})
 }
 
+func TestResourceAdmissionControlPolicyLifecycle(t *testing.T) {
+	api := newFakeDome9API(t)
+	policyTypeAndName, _, policyGeneratedName := method.GenerateRandomSourcesTypeAndName(resourcetype.AdmissionControlPolicy)
+	notificationTypeAndName, _, notificationGeneratedName := method.GenerateRandomSourcesTypeAndName(resourcetype.Notification)
+	kubernetesAccountResourceTypeAndName, _, kubernetesAccountGeneratedName := method.GenerateRandomSourcesTypeAndName(resourcetype.CloudAccountKubernetes)
+
+	kubernetesAccountHCL := getCloudAccountKubernetesResourceHCLWithfeatures(kubernetesAccountGeneratedName, variable.AdmissionControlKubernetesAccountName,
+		variable.CloudAccountKubernetesRuntimeProtectionEnabled,
+		variable.CloudAccountKubernetesAdmissionControlEnabled,
+		variable.CloudAccountKubernetesImageAssuranceEnabled,
+		variable.CloudAccountKubernetesThreatIntelligenceEnabled)
+	notificationHCL := getNotificationResourceHCL(notificationGeneratedName, notificationConfig(notificationGeneratedName))
+	policyHCL := getAdmissionControlPolicyResourceHCL(kubernetesAccountHCL, kubernetesAccountResourceTypeAndName, notificationHCL,
+		notificationTypeAndName, policyGeneratedName, false)
+	updateConfig := getAdmissionControlPolicyResourceHCL(kubernetesAccountHCL, kubernetesAccountResourceTypeAndName, notificationHCL,
+		notificationTypeAndName, policyGeneratedName, true)
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    api.providers(),
+		CheckDestroy: api.checkDestroyed("kubernetes/admissionControl/policy"),
+		Steps: []resource.TestStep{
+			{
+				Config: api.config(testAccCheckAdmissionControlPolicyBasic(policyHCL, policyGeneratedName, policyTypeAndName)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(policyTypeAndName, "target_id", kubernetesAccountResourceTypeAndName, "id"),
+					resource.TestCheckResourceAttrPair(policyTypeAndName, "notification_ids.0", notificationTypeAndName, "id"),
+					resource.TestCheckResourceAttr(policyTypeAndName, "action", variable.AdmissionControlPolicyDetectAction),
+				),
+			},
+			{
+				Config: api.config(updateConfig),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(policyTypeAndName, "target_id", kubernetesAccountResourceTypeAndName, "id"),
+					resource.TestCheckResourceAttr(policyTypeAndName, "action", variable.AdmissionControlPolicyPreventAction),
+				),
+			},
+			api.importStep(updateConfig, policyTypeAndName),
+		},
+	})
+}
+
 func testAccCheckAdmissionControlPolicyExists(resource string, acPolicy *admission_policy.AdmissionControlPolicyResponse) resource.TestCheckFunc {
 	return func(state *terraform.State) error {
 		rs, ok := state.RootModule().Resources[resource]
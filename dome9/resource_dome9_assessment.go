@@ -1,7 +1,6 @@
 package dome9
 
 import (
-	"github.com/dome9/dome9-sdk-go/dome9/client"
 	"github.com/dome9/dome9-sdk-go/services/assessment"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
@@ -59,6 +58,23 @@ func resourceAssessment() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"fail_on": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: assessmentFailOnSchema(),
+				},
+			},
+			"report": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: assessmentReportSchema(),
+				},
+			},
 			"request": {
 				Type:     schema.TypeSet,
 				Computed: true,
@@ -676,7 +692,21 @@ func resourceAssessmentCreate(d *schema.ResourceData, meta interface{}) error {
 	log.Printf("[INFO] Created assessment. ID: %v\n", resp.ID)
 	d.SetId(strconv.Itoa(resp.ID))
 
-	return resourceAssessmentRead(d, meta)
+	if err := resourceAssessmentRead(d, meta); err != nil {
+		return err
+	}
+
+	// the reports are written before fail_on is checked, to show why the assessment failed
+	if err := writeAssessmentReports(d, resp); err != nil {
+		return err
+	}
+
+	// the failed assessment is kept in the state as tainted, the next apply runs it again
+	if failOn := expandAssessmentFailOn(d); failOn != nil {
+		return failOn.check(resp)
+	}
+
+	return nil
 }
 
 func resourceAssessmentRead(d *schema.ResourceData, meta interface{}) error {
@@ -684,7 +714,7 @@ func resourceAssessmentRead(d *schema.ResourceData, meta interface{}) error {
 	resp, _, err := d9Client.assessment.Get(d.Id())
 
 	if err != nil {
-		if err.(*client.ErrorResponse).IsObjectNotFound() {
+		if isNotFoundError(err) {
 			log.Printf("[WARN] Removing assessment %s from state because it no longer exists in Dome9", d.Id())
 			d.SetId("")
 			return nil
@@ -5,7 +5,6 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 
-	"github.com/dome9/dome9-sdk-go/dome9/client"
 	"github.com/dome9/dome9-sdk-go/services/cloudaccounts"
 	"github.com/dome9/dome9-sdk-go/services/cloudaccounts/aws"
 )
@@ -65,7 +64,7 @@ func resourceAttachIAMSafeRead(d *schema.ResourceData, meta interface{}) error {
 	resp, _, err := d9Client.cloudaccountAWS.Get(&getCloudAccountQueryParams)
 
 	if err != nil {
-		if err.(*client.ErrorResponse).IsObjectNotFound() {
+		if isNotFoundError(err) {
 			log.Printf("[WARN] Removing IAM safe from state because aws cloud account %s it no longer exists in Dome9", d.Id())
 			d.SetId("")
 			return nil
@@ -8,7 +8,6 @@ import (
 	"strings"
 	"time"
 
-	"github.com/dome9/dome9-sdk-go/dome9/client"
 	"github.com/dome9/dome9-sdk-go/services/awp"
 	"github.com/dome9/dome9-sdk-go/services/awp/aws_onboarding"
 	"github.com/dome9/dome9-sdk-go/services/cloudaccounts"
@@ -26,6 +25,7 @@ func resourceAwpAwsOnboarding() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		Timeouts: onboardingResourceTimeouts(),
 		Schema: map[string]*schema.Schema{
 			"cloudguard_account_id": {
 				Type:     schema.TypeString,
@@ -178,6 +178,13 @@ func resourceAWPAWSOnboardingCreate(d *schema.ResourceData, meta interface{}) er
 	d.SetId(cloudguardAccountId) // set the resource ID to the CloudGuard Account ID
 	log.Printf("[INFO] Created AWP AWS Onboarding with CloudGuard Account ID: %v\n", cloudguardAccountId)
 
+	if err := waitForAWPOnboardingReady("AWP AWS Onboarding "+d.Id(), d.Timeout(schema.TimeoutCreate), func() (*awp_onboarding.GetAWPOnboardingResponse, error) {
+		resp, _, err := d9client.awpAwsOnboarding.GetAWPOnboarding(d.Id())
+		return resp, err
+	}); err != nil {
+		return err
+	}
+
 	return resourceAWPAWSOnboardingRead(d, meta)
 }
 
@@ -214,7 +221,7 @@ func resourceAWPAWSOnboardingRead(d *schema.ResourceData, meta interface{}) erro
 	d9client := meta.(*Client)
 	resp, _, err := d9client.awpAwsOnboarding.GetAWPOnboarding(d.Id())
 	if err != nil {
-		if err.(*client.ErrorResponse).IsObjectNotFound() {
+		if isNotFoundError(err) {
 			log.Printf("[WARN] Removing AWS cloud account %s from state because it no longer exists in Dome9", d.Id())
 			d.SetId("")
 			return nil
@@ -251,6 +258,12 @@ func resourceAWPAWSOnboardingDelete(d *schema.ResourceData, meta interface{}) er
 	if err != nil {
 		return err
 	}
+	if err := waitForOnboardingDeleted("AWP AWS Onboarding "+d.Id(), d.Timeout(schema.TimeoutDelete), func() error {
+		_, _, err := d9client.awpAwsOnboarding.GetAWPOnboarding(d.Id())
+		return err
+	}); err != nil {
+		return err
+	}
 	if d.Get("scan_mode").(string) == "inAccountSub" {
 		// delay for 30 seconds to allow the account to be removed from the hub
 		time.Sleep(30 * time.Second)
@@ -401,6 +414,13 @@ func resourceAWPAWSOnboardingUpdate(d *schema.ResourceData, meta interface{}) er
 			return err
 		}
 		log.Printf("[INFO] Updated agentless account settings for cloud account %s\n", d.Id())
+
+		if err := waitForAWPOnboardingReady("AWP AWS Onboarding "+d.Id(), d.Timeout(schema.TimeoutUpdate), func() (*awp_onboarding.GetAWPOnboardingResponse, error) {
+			resp, _, err := d9Client.awpAwsOnboarding.GetAWPOnboarding(d.Id())
+			return resp, err
+		}); err != nil {
+			return err
+		}
 	}
 
 	return nil
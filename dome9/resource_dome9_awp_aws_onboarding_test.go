@@ -87,6 +87,48 @@ func TestAccResourceAWPAWSOnboardingBasic(t *testing.T) {
 	})
 }
 
+func TestResourceAWPAWSOnboardingLifecycle(t *testing.T) {
+	api := newFakeDome9API(t)
+	resourceTypeAndName, _, generatedName := method.GenerateRandomSourcesTypeAndName(resourcetype.AwpAwsOnboarding)
+	disabledRegionUpdate3, _ := getRegionByIndex(variable.DisabledRegionsUpdate, 2)
+	updateConfig := testAccCheckAWPAWSOnboardingBasic(getAwpAwsOnboardingResourceHCL(generatedName, "external-id", true))
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    api.providers(),
+		CheckDestroy: api.checkDestroyed("workload/agentless/aws/accounts"),
+		Steps: []resource.TestStep{
+			{
+				Config: api.config(testAccCheckAWPAWSOnboardingBasic(getAwpAwsOnboardingResourceHCL(generatedName, "external-id", false))),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceTypeAndName, "id", variable.OnboardedAwsCloudGuardAccountID),
+					resource.TestCheckResourceAttr(resourceTypeAndName, "scan_mode", variable.ScanMode),
+					resource.TestCheckResourceAttr(resourceTypeAndName, "agentless_protection_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceTypeAndName, "cloud_provider", "aws"),
+					resource.TestCheckResourceAttr(resourceTypeAndName, "agentless_account_settings.0.scan_machine_interval_in_hours", variable.ScanMachineIntervalInHours),
+					resource.TestCheckResourceAttr(resourceTypeAndName, "agentless_account_settings.0.custom_tags.%", "2"),
+				),
+			},
+			{
+				Config: api.config(updateConfig),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceTypeAndName, "agentless_account_settings.0.disabled_regions.2", disabledRegionUpdate3),
+					resource.TestCheckResourceAttr(resourceTypeAndName, "agentless_account_settings.0.scan_machine_interval_in_hours", variable.ScanMachineIntervalInHoursUpdate),
+					resource.TestCheckResourceAttr(resourceTypeAndName, "agentless_account_settings.0.custom_tags.%", "3"),
+					func(*terraform.State) error {
+						if !api.requested("PATCH /v2/workload/agentless/aws/accounts/" + variable.OnboardedAwsCloudGuardAccountID + "/settings") {
+							return fmt.Errorf("the agentless account settings were not updated")
+						}
+						return nil
+					},
+				),
+			},
+			// Read only sets what the API reports, the inputs of the onboarding are kept from the configuration
+			api.importStep(updateConfig, resourceTypeAndName, "cloudguard_account_id", "cross_account_role_name",
+				"cross_account_role_external_id", "awp_version", "force_delete", "should_create_policy"),
+		},
+	})
+}
+
 func testAccCheckAWPAWSOnboardingDestroy(state *terraform.State) error {
 	apiClient := testAccProvider.Meta().(*Client)
 	for _, rs := range state.RootModule().Resources {
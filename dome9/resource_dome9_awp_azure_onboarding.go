@@ -7,7 +7,6 @@ import (
 	"strconv"
 	"strings"
 
-	"github.com/dome9/dome9-sdk-go/dome9/client"
 	"github.com/dome9/dome9-sdk-go/services/awp"
 	"github.com/dome9/dome9-sdk-go/services/awp/azure_onboarding"
 	"github.com/dome9/dome9-sdk-go/services/cloudaccounts"
@@ -25,6 +24,7 @@ func resourceAwpAzureOnboarding() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		Timeouts: onboardingResourceTimeouts(),
 		Schema: map[string]*schema.Schema{
 			"cloudguard_account_id": {
 				Type:     schema.TypeString,
@@ -152,6 +152,13 @@ func resourceAWPAzureOnboardingCreate(d *schema.ResourceData, meta interface{})
 	d.SetId(cloudguardAccountId) // set the resource ID to the CloudGuard Account ID
 	log.Printf("[INFO] Created AWP Azure Onboarding with CloudGuard Account ID: %v\n", cloudguardAccountId)
 
+	if err := waitForAWPOnboardingReady("AWP Azure Onboarding "+d.Id(), d.Timeout(schema.TimeoutCreate), func() (*awp_onboarding.GetAWPOnboardingResponse, error) {
+		resp, _, err := d9client.awpAzureOnboarding.GetAWPOnboarding(d.Id())
+		return resp, err
+	}); err != nil {
+		return err
+	}
+
 	return resourceAWPAzureOnboardingRead(d, meta)
 }
 
@@ -194,7 +201,7 @@ func resourceAWPAzureOnboardingRead(d *schema.ResourceData, meta interface{}) er
 	d9client := meta.(*Client)
 	resp, _, err := d9client.awpAzureOnboarding.GetAWPOnboarding(d.Id())
 	if err != nil {
-		if err.(*client.ErrorResponse).IsObjectNotFound() {
+		if isNotFoundError(err) {
 			log.Printf("[WARN] Removing Azure cloud account %s from state because it no longer exists in Dome9", d.Id())
 			d.SetId("")
 			return nil
@@ -229,7 +236,11 @@ func resourceAWPAzureOnboardingDelete(d *schema.ResourceData, meta interface{})
 	if err != nil {
 		return err
 	}
-	return nil
+
+	return waitForOnboardingDeleted("AWP Azure Onboarding "+d.Id(), d.Timeout(schema.TimeoutDelete), func() error {
+		_, _, err := d9client.awpAzureOnboarding.GetAWPOnboarding(d.Id())
+		return err
+	})
 }
 
 func expandAgentlessAccountSettingsAzure(d *schema.ResourceData) (*awp_onboarding.AgentlessAccountSettings, error) {
@@ -366,6 +377,13 @@ func resourceAWPAzureOnboardingUpdate(d *schema.ResourceData, meta interface{})
 			return err
 		}
 		log.Printf("[INFO] Updated agentless account settings for cloud account %s\n", d.Id())
+
+		if err := waitForAWPOnboardingReady("AWP Azure Onboarding "+d.Id(), d.Timeout(schema.TimeoutUpdate), func() (*awp_onboarding.GetAWPOnboardingResponse, error) {
+			resp, _, err := d9Client.awpAzureOnboarding.GetAWPOnboarding(d.Id())
+			return resp, err
+		}); err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -138,6 +138,41 @@ resource "%s" "%s" {
 	)
 }
 
+func TestResourceAWPAzureOnboardingLifecycle(t *testing.T) {
+	api := newFakeDome9API(t)
+	resourceTypeAndName, _, generatedName := method.GenerateRandomSourcesTypeAndName(resourcetype.AwpAzureOnboarding)
+	disabledRegionUpdate3, _ := getRegionByIndex(variable.AzureDisabledRegionsUpdate, 2)
+	updateConfig := testAccCheckAWPAzureOnboardingBasic(getAwpAzureOnboardingResourceHCL(generatedName, true))
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    api.providers(),
+		CheckDestroy: api.checkDestroyed("workload/agentless/azure/accounts"),
+		Steps: []resource.TestStep{
+			{
+				Config: api.config(testAccCheckAWPAzureOnboardingBasic(getAwpAzureOnboardingResourceHCL(generatedName, false))),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceTypeAndName, "id", variable.OnboardedAzureCloudGuardAccountID),
+					resource.TestCheckResourceAttr(resourceTypeAndName, "scan_mode", variable.ScanMode),
+					resource.TestCheckResourceAttr(resourceTypeAndName, "agentless_protection_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceTypeAndName, "cloud_provider", "azure"),
+					resource.TestCheckResourceAttr(resourceTypeAndName, "agentless_account_settings.0.scan_machine_interval_in_hours", variable.ScanMachineIntervalInHours),
+					resource.TestCheckResourceAttr(resourceTypeAndName, "agentless_account_settings.0.custom_tags.%", "2"),
+				),
+			},
+			{
+				Config: api.config(updateConfig),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceTypeAndName, "agentless_account_settings.0.disabled_regions.2", disabledRegionUpdate3),
+					resource.TestCheckResourceAttr(resourceTypeAndName, "agentless_account_settings.0.scan_machine_interval_in_hours", variable.ScanMachineIntervalInHoursUpdate),
+					resource.TestCheckResourceAttr(resourceTypeAndName, "agentless_account_settings.0.custom_tags.%", "3"),
+				),
+			},
+			// Read only sets what the API reports, the inputs of the onboarding are kept from the configuration
+			api.importStep(updateConfig, resourceTypeAndName, "cloudguard_account_id", "should_create_policy"),
+		},
+	})
+}
+
 func testAccCheckAwpAzureAccountExists(resource string, awpAccount *awp_onboarding.GetAWPOnboardingResponse) resource.TestCheckFunc {
 	return func(state *terraform.State) error {
 		rs, ok := state.RootModule().Resources[resource]
@@ -2,12 +2,13 @@ package dome9
 
 import (
 	"encoding/json"
-	"github.com/dome9/dome9-sdk-go/dome9/client"
 	"github.com/dome9/dome9-sdk-go/services/cloudaccounts/aws_org"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"log"
 	"regexp"
+
+	"github.com/terraform-providers/terraform-provider-dome9/dome9/common/resourcetype"
 )
 
 func resourceAwsOrganizationOnboarding() *schema.Resource {
@@ -19,6 +20,7 @@ func resourceAwsOrganizationOnboarding() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		Timeouts: onboardingResourceTimeouts(),
 
 		Schema: map[string]*schema.Schema{
 			// OnboardingRequest object fields
@@ -136,6 +138,7 @@ func resourceAwsOrganizationOnboarding() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"deletion_protection": deletionProtectionSchema(),
 		},
 	}
 }
@@ -153,6 +156,15 @@ func resourceAwsOrganizationOnboardingCreate(d *schema.ResourceData, meta interf
 	log.Printf("[INFO] Created Aws organization. ID: %v\n", resp.Id)
 	d.SetId(resp.Id)
 
+	if _, err := waitForOnboardingState("Aws organization "+d.Id(), d.Timeout(schema.TimeoutCreate),
+		[]string{onboardingStateRegistering}, []string{onboardingStateReady},
+		onboardingRegisteredRefreshFunc(func() (interface{}, error) {
+			resp, _, err := d9Client.awsOrganizationOnboarding.Get(d.Id())
+			return resp, err
+		}, nil)); err != nil {
+		return err
+	}
+
 	return resourceAwsOrganizationOnboardingRead(d, meta)
 }
 
@@ -161,7 +173,7 @@ func resourceAwsOrganizationOnboardingRead(d *schema.ResourceData, meta interfac
 	resp, _, err := d9Client.awsOrganizationOnboarding.Get(d.Id())
 
 	if err != nil {
-		if err.(*client.ErrorResponse).IsObjectNotFound() {
+		if isNotFoundError(err) {
 			log.Printf("[WARN] Removing Aws organization %s from state because it no longer exists in CloudGuard", d.Id())
 			d.SetId("")
 			return nil
@@ -187,17 +199,26 @@ func resourceAwsOrganizationOnboardingRead(d *schema.ResourceData, meta interfac
 		return err
 	}
 
+	setMissingDefaults(d, map[string]interface{}{"deletion_protection": false})
+
 	return nil
 }
 
 func resourceAwsOrganizationOnboardingDelete(d *schema.ResourceData, meta interface{}) error {
+	if err := checkDeletionProtection(d, resourcetype.AWSOrganizationOnboarding); err != nil {
+		return err
+	}
+
 	d9Client := meta.(*Client)
 	log.Printf("[INFO] Deleting Aws organization ID: %v\n", d.Id())
 	if _, err := d9Client.awsOrganizationOnboarding.Delete(d.Id()); err != nil {
 		return err
 	}
 
-	return nil
+	return waitForOnboardingDeleted("Aws organization "+d.Id(), d.Timeout(schema.TimeoutDelete), func() error {
+		_, _, err := d9Client.awsOrganizationOnboarding.Get(d.Id())
+		return err
+	})
 }
 
 func resourceAwsOrganizationOnboardingUpdate(d *schema.ResourceData, meta interface{}) error {
@@ -207,13 +228,25 @@ func resourceAwsOrganizationOnboardingUpdate(d *schema.ResourceData, meta interf
 	if d.HasChange("stack_set_arn") {
 		log.Println("The StackSet ARN has been changed")
 
+		stackSetArn := d.Get("stack_set_arn").(string)
 		if resp, err := d9Client.awsOrganizationOnboarding.UpdateStackSetArn(d.Id(), aws_org.UpdateStackSetArnRequest{
-			StackSetArn: d.Get("stack_set_arn").(string),
+			StackSetArn: stackSetArn,
 		}); err != nil {
 			return err
 		} else {
 			log.Printf("resourceAwsOrganizationOnboardingUpdate StackSetArn response is: %+v\n", resp)
 		}
+
+		if _, err := waitForOnboardingState("Aws organization "+d.Id()+" StackSet ARN update", d.Timeout(schema.TimeoutUpdate),
+			[]string{onboardingStatePending}, []string{onboardingStateReady},
+			onboardingRegisteredRefreshFunc(func() (interface{}, error) {
+				resp, _, err := d9Client.awsOrganizationOnboarding.Get(d.Id())
+				return resp, err
+			}, func(resp interface{}) bool {
+				return resp.(*aws_org.OrganizationManagementViewModel).StackSetArn == stackSetArn
+			})); err != nil {
+			return err
+		}
 	}
 
 	if d.HasChange("organization_root_ou_id") || d.HasChange("mapping_strategy") || d.HasChange("posture_management") {
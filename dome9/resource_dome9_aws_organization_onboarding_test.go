@@ -39,6 +39,50 @@ func TestAccResourceAwsOrganizationOnboardingBasic(t *testing.T) {
 	})
 }
 
+func TestResourceAwsOrganizationOnboardingLifecycle(t *testing.T) {
+	api := newFakeDome9API(t)
+	resourceTypeAndName, _, generatedName := method.GenerateRandomSourcesTypeAndName(resourcetype.AWSOrganizationOnboarding)
+	t.Setenv(environmentvariable.AwsOrganizationOnboardingEnvVarRoleArn, "arn:aws:iam::123456789012:role/CloudGuard-Connect")
+	t.Setenv(environmentvariable.AwsOrganizationOnboardingEnvVarSecret, "secret")
+	t.Setenv(environmentvariable.AwsOrganizationOnboardingEnvVarStackSetArn, "arn:aws:cloudformation:us-east-1:123456789012:stackset/CloudGuard:1")
+	createConfig := testAccCheckAwsOrganizationOnboardingConfigure(resourceTypeAndName, generatedName)
+	updatedStackSetArn := "arn:aws:cloudformation:us-east-1:123456789012:stackset/CloudGuard:2"
+	t.Setenv(environmentvariable.AwsOrganizationOnboardingEnvVarStackSetArn, updatedStackSetArn)
+	updateConfig := getAwsOrganizationOnboardingResourceHCL(generatedName)
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    api.providers(),
+		CheckDestroy: api.checkDestroyed("aws-organization-management"),
+		Steps: []resource.TestStep{
+			{
+				Config: api.config(createConfig),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceTypeAndName, "organization_name", variable.AwsOrganizationOnboardingCreationResourceName),
+					resource.TestCheckResourceAttr(resourceTypeAndName, "external_organization_id", "o-fake"),
+					resource.TestCheckResourceAttr(resourceTypeAndName, "onboarding_configuration.mapping_strategy", "Flat"),
+					resource.TestCheckResourceAttrPair("data."+resourceTypeAndName, "id", resourceTypeAndName, "id"),
+				),
+			},
+			{
+				Config: api.config(updateConfig),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceTypeAndName, "stack_set_arn", updatedStackSetArn),
+					func(s *terraform.State) error {
+						id := s.RootModule().Resources[resourceTypeAndName].Primary.ID
+						if !api.requested("PUT /v2/aws-organization-management/" + id + "/stackset-arn") {
+							return fmt.Errorf("the StackSet ARN was not updated")
+						}
+						return nil
+					},
+				),
+			},
+			// the API does not return the onboarding request
+			api.importStep(updateConfig, resourceTypeAndName,
+				"role_arn", "secret", "stack_set_arn", "aws_organization_name", "enable_stack_modify", "type"),
+		},
+	})
+}
+
 func testAccCheckAwsOrganizationOnboardingDestroy(s *terraform.State) error {
 	apiClient := testAccProvider.Meta().(*Client)
 
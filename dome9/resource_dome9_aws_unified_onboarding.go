@@ -2,6 +2,7 @@ package dome9
 
 import (
 	"encoding/json"
+	"github.com/dome9/dome9-sdk-go/services/cloudaccounts"
 	"github.com/dome9/dome9-sdk-go/services/unifiedonboarding/aws_unified_onboarding"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/terraform-providers/terraform-provider-dome9/dome9/common/providerconst"
@@ -18,6 +19,7 @@ func resourceAwsUnifiedOnboarding() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		Timeouts: onboardingResourceTimeouts(),
 		Schema: map[string]*schema.Schema{
 			providerconst.OnboardType: {
 				Type:     schema.TypeString,
@@ -78,6 +80,11 @@ func resourceAwsUnifiedOnboarding() *schema.Resource {
 					},
 				},
 			},
+			providerconst.WaitForCompletion: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			providerconst.StackName: {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -117,6 +124,20 @@ func resourceUnifiedOnboardingCreate(d *schema.ResourceData, meta interface{}) e
 	_ = d.Set(providerconst.IamCapabilities, resp.IamCapabilities)
 	_ = d.Set(providerconst.TemplateUrl, resp.TemplateUrl)
 
+	if d.Id() != "" {
+		// the CloudFormation stack is usually deployed from this resource's outputs, which are only available once the
+		// create returns, so by default only wait for the onboarding to be registered
+		pending, target := []string{onboardingStateRegistering}, []string{onboardingStatePending, onboardingStateReady}
+		if d.Get(providerconst.WaitForCompletion).(bool) {
+			pending, target = []string{onboardingStateRegistering, onboardingStatePending}, []string{onboardingStateReady}
+		}
+
+		if _, err := waitForOnboardingState("AWS unified onboarding "+d.Id(), d.Timeout(schema.TimeoutCreate),
+			pending, target, unifiedOnboardingRefreshFunc(d9Client, d.Id())); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -227,7 +248,10 @@ func resourceUnifiedOnboardingDelete(data *schema.ResourceData, meta interface{}
 		return err
 	}
 
-	return nil
+	return waitForOnboardingDeleted("AWS cloud account "+receivedAwsUnifiedOnboardingResponse.EnvironmentId, data.Timeout(schema.TimeoutDelete), func() error {
+		_, _, err := d9Client.cloudaccountAWS.Get(&cloudaccounts.QueryParameters{ID: receivedAwsUnifiedOnboardingResponse.EnvironmentId})
+		return err
+	})
 }
 
 func resourceUnifiedOnboardingUpdate(data *schema.ResourceData, i interface{}) error {
@@ -34,6 +34,33 @@ func TestAccResourceAwsUnifiedOnboardingBasic(t *testing.T) {
 	})
 }
 
+func TestResourceAwsUnifiedOnboardingLifecycle(t *testing.T) {
+	api := newFakeDome9API(t)
+	resourceTypeAndName, _, generatedName := method.GenerateRandomSourcesTypeAndName(resourcetype.AwsUnifiedOnboarding)
+	dataSourceTypeAndName := "data." + resourceTypeAndName + variable.DataSourceSuffix
+
+	// Read does not refresh the onboarding, there is no import step
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    api.providers(),
+		CheckDestroy: api.checkDestroyed("cloudaccounts"),
+		Steps: []resource.TestStep{
+			{
+				Config: api.config(testAccCheckAwsUnifiedOnboardingBasic(resourceTypeAndName, generatedName)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceTypeAndName, "iam_capabilities.0", variable.AwsUnifiedOnbordingIamCapabilities0),
+					resource.TestCheckResourceAttr(resourceTypeAndName, "iam_capabilities.1", variable.AwsUnifiedOnbordingIamCapabilities1),
+					resource.TestCheckResourceAttr(resourceTypeAndName, "iam_capabilities.2", variable.AwsUnifiedOnbordingIamCapabilities2),
+					resource.TestCheckResourceAttr(resourceTypeAndName, "onboard_type", variable.AwsUnifiedOnbordingOnboardType),
+					resource.TestCheckResourceAttr(resourceTypeAndName, "cloud_vendor", variable.AwsUnifiedOnbordingCloudVendor),
+					resource.TestCheckResourceAttrPair(resourceTypeAndName, "parameters.OnboardingId", resourceTypeAndName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceTypeAndName, "onboarding_id", resourceTypeAndName, "id"),
+					resource.TestCheckResourceAttrSet(dataSourceTypeAndName, "environment_id"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckAwsUnifiedOnboardingExists(resource string, awsUnifiedOnboarding *aws_unified_onboarding.UnifiedOnboardingResponse) resource.TestCheckFunc {
 	return func(state *terraform.State) error {
 		rs, ok := state.RootModule().Resources[resource]
@@ -1,12 +1,13 @@
 package dome9
 
 import (
-	"github.com/dome9/dome9-sdk-go/dome9/client"
 	"github.com/dome9/dome9-sdk-go/services/cloudaccounts/aws_org"
 	"github.com/dome9/dome9-sdk-go/services/cloudaccounts/azure_org"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"log"
+
+	"github.com/terraform-providers/terraform-provider-dome9/dome9/common/resourcetype"
 )
 
 func resourceAzureOrganizationOnboarding() *schema.Resource {
@@ -18,6 +19,7 @@ func resourceAzureOrganizationOnboarding() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		Timeouts: onboardingResourceTimeouts(),
 
 		Schema: map[string]*schema.Schema{
 			"workflow_id": {
@@ -294,6 +296,7 @@ func resourceAzureOrganizationOnboarding() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"deletion_protection": deletionProtectionSchema(),
 		},
 	}
 }
@@ -311,6 +314,15 @@ func resourceAzureOrganizationOnboardingCreate(d *schema.ResourceData, meta inte
 	log.Printf("[INFO] Created Azure organization. ID: %v\n", resp.Id)
 	d.SetId(resp.Id)
 
+	if _, err := waitForOnboardingState("Azure organization "+d.Id(), d.Timeout(schema.TimeoutCreate),
+		[]string{onboardingStateRegistering}, []string{onboardingStateReady},
+		onboardingRegisteredRefreshFunc(func() (interface{}, error) {
+			resp, _, err := d9Client.azureOrganizationOnboarding.Get(d.Id())
+			return resp, err
+		}, nil)); err != nil {
+		return err
+	}
+
 	return resourceAzureOrganizationOnboardingRead(d, meta)
 }
 
@@ -319,7 +331,7 @@ func resourceAzureOrganizationOnboardingRead(d *schema.ResourceData, meta interf
 	resp, _, err := d9Client.azureOrganizationOnboarding.Get(d.Id())
 
 	if err != nil {
-		if err.(*client.ErrorResponse).IsObjectNotFound() {
+		if isNotFoundError(err) {
 			log.Printf("[WARN] Removing Azure organization %s from state because it no longer exists in CloudGuard", d.Id())
 			d.SetId("")
 			return nil
@@ -343,6 +355,8 @@ func resourceAzureOrganizationOnboardingRead(d *schema.ResourceData, meta interf
 		return err
 	}
 
+	setMissingDefaults(d, map[string]interface{}{"deletion_protection": false})
+
 	return nil
 }
 
@@ -353,6 +367,12 @@ func resourceAzureOrganizationOnboardingUpdate(d *schema.ResourceData, meta inte
 	if d.HasChange("organization_name") {
 		log.Println("The configuration has been changed")
 
+		// the update is applied asynchronously, the name or the update time of the organization tell when it is done
+		current, _, err := d9Client.azureOrganizationOnboarding.Get(d.Id())
+		if err != nil {
+			return err
+		}
+
 		updateConfigReq := azure_org.OnboardingUpdateRequest{
 			OrganizationName: d.Get("organization_name").(string),
 		}
@@ -362,19 +382,48 @@ func resourceAzureOrganizationOnboardingUpdate(d *schema.ResourceData, meta inte
 		} else {
 			log.Printf("resourceAzureOrganizationOnboardingUpdate Configuration response is: %+v\n", resp)
 		}
+
+		if _, err := waitForOnboardingState("Azure organization "+d.Id()+" update", d.Timeout(schema.TimeoutUpdate),
+			[]string{onboardingStatePending}, []string{onboardingStateReady},
+			onboardingRegisteredRefreshFunc(func() (interface{}, error) {
+				resp, _, err := d9Client.azureOrganizationOnboarding.Get(d.Id())
+				return resp, err
+			}, func(resp interface{}) bool {
+				return isAzureOrganizationUpdated(resp.(*azure_org.OrganizationManagementViewModel), current.UpdateTime, updateConfigReq)
+			})); err != nil {
+			return err
+		}
 	}
 
-	return nil
+	return resourceAzureOrganizationOnboardingRead(d, meta)
+}
+
+// isAzureOrganizationUpdated reports whether the organization already matches req, an empty organization name leaving
+// the name to the API, or was updated after previousUpdateTime. The API does not change the update time of an update
+// leaving the organization as it was, and may store another name than the requested one.
+func isAzureOrganizationUpdated(resp *azure_org.OrganizationManagementViewModel, previousUpdateTime string, req azure_org.OnboardingUpdateRequest) bool {
+	if req.OrganizationName == "" || resp.OrganizationName == req.OrganizationName {
+		return true
+	}
+
+	return resp.UpdateTime != previousUpdateTime
 }
 
 func resourceAzureOrganizationOnboardingDelete(d *schema.ResourceData, meta interface{}) error {
+	if err := checkDeletionProtection(d, resourcetype.AzureOrganizationOnboarding); err != nil {
+		return err
+	}
+
 	d9Client := meta.(*Client)
 	log.Printf("[INFO] Deleting Azure organization ID: %v\n", d.Id())
 	if _, err := d9Client.azureOrganizationOnboarding.Delete(d.Id()); err != nil {
 		return err
 	}
 
-	return nil
+	return waitForOnboardingDeleted("Azure organization "+d.Id(), d.Timeout(schema.TimeoutDelete), func() error {
+		_, _, err := d9Client.azureOrganizationOnboarding.Get(d.Id())
+		return err
+	})
 }
 
 func expandAzureOrganizationOnboardingRequest(d *schema.ResourceData) azure_org.OnboardingRequest {
@@ -39,6 +39,36 @@ func TestAccResourceAzureOrganizationOnboardingBasic(t *testing.T) {
 	})
 }
 
+func TestResourceAzureOrganizationOnboardingLifecycle(t *testing.T) {
+	api := newFakeDome9API(t)
+	resourceTypeAndName, _, generatedName := method.GenerateRandomSourcesTypeAndName(resourcetype.AzureOrganizationOnboarding)
+	tenantID := "00000000-0000-0000-0000-0000000000a1"
+	t.Setenv(environmentvariable.CloudAccountOrgAzureEnvVarTenantId, tenantID)
+	t.Setenv(environmentvariable.CloudAccountOrgAzureEnvVarManagementGroupId, tenantID)
+	updateConfig := getAzureOrganizationOnboardingResourceHCL(generatedName, `organization_name = "Production"`)
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    api.providers(),
+		CheckDestroy: api.checkDestroyed("azure-organization-management"),
+		Steps: []resource.TestStep{
+			{
+				Config: api.config(testAccCheckAzureOrganizationOnboardingConfigure(resourceTypeAndName, generatedName)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceTypeAndName, "tenant_id", tenantID),
+					resource.TestCheckResourceAttr(resourceTypeAndName, "organization_name", "Tenant Root Group"),
+					resource.TestCheckResourceAttrPair("data."+resourceTypeAndName, "id", resourceTypeAndName, "id"),
+				),
+			},
+			{
+				Config: api.config(updateConfig),
+				Check:  resource.TestCheckResourceAttr(resourceTypeAndName, "organization_name", "Production"),
+			},
+			// the API does not return the onboarding request
+			api.importStep(updateConfig, resourceTypeAndName, "active_blades", "use_cloud_guard_managed_app", "vendor"),
+		},
+	})
+}
+
 func testAccCheckAzureOrganizationOnboardingExists(resource string, resp *azure_org.OrganizationManagementViewModel) resource.TestCheckFunc {
 	return func(state *terraform.State) error {
 		rs, ok := state.RootModule().Resources[resource]
@@ -99,7 +129,7 @@ data "%s" "%s" {
 }
 `,
 		// Resource code
-		getAzureOrganizationOnboardingResourceHCL(generatedName),
+		getAzureOrganizationOnboardingResourceHCL(generatedName, ""),
 
 		// Data source variables
 		resourcetype.AzureOrganizationOnboarding,
@@ -108,7 +138,7 @@ data "%s" "%s" {
 	)
 }
 
-func getAzureOrganizationOnboardingResourceHCL(resourceName string) string {
+func getAzureOrganizationOnboardingResourceHCL(resourceName, additionalBlock string) string {
 	return fmt.Sprintf(`
 resource "%s" "%s" {
   tenant_id = "%s"
@@ -130,6 +160,7 @@ resource "%s" "%s" {
   use_cloud_guard_managed_app = true
   is_auto_onboarding = true
   vendor = "%s"
+  %s
 }
 `,
 		//Resource variables
@@ -138,5 +169,53 @@ resource "%s" "%s" {
 		os.Getenv(environmentvariable.CloudAccountOrgAzureEnvVarTenantId),
 		os.Getenv(environmentvariable.CloudAccountOrgAzureEnvVarManagementGroupId),
 		variable.AzureOrganizationOnboardingVendorName,
+		additionalBlock,
 	)
 }
+
+func TestIsAzureOrganizationUpdated(t *testing.T) {
+	const previousUpdateTime = "2024-01-01T00:00:00Z"
+	cases := []struct {
+		name string
+		resp azure_org.OrganizationManagementViewModel
+		req  azure_org.OnboardingUpdateRequest
+		want bool
+	}{
+		{
+			name: "update not applied yet",
+			resp: azure_org.OrganizationManagementViewModel{OrganizationName: "Staging", UpdateTime: previousUpdateTime},
+			req:  azure_org.OnboardingUpdateRequest{OrganizationName: "Production"},
+		},
+		{
+			name: "update applied",
+			resp: azure_org.OrganizationManagementViewModel{OrganizationName: "Production", UpdateTime: "2024-01-01T00:01:00Z"},
+			req:  azure_org.OnboardingUpdateRequest{OrganizationName: "Production"},
+			want: true,
+		},
+		{
+			name: "organization already matching the update",
+			resp: azure_org.OrganizationManagementViewModel{OrganizationName: "Production", UpdateTime: previousUpdateTime},
+			req:  azure_org.OnboardingUpdateRequest{OrganizationName: "Production"},
+			want: true,
+		},
+		{
+			name: "name changed by the API",
+			resp: azure_org.OrganizationManagementViewModel{OrganizationName: "production", UpdateTime: "2024-01-01T00:01:00Z"},
+			req:  azure_org.OnboardingUpdateRequest{OrganizationName: "Production"},
+			want: true,
+		},
+		{
+			name: "name left to the API",
+			resp: azure_org.OrganizationManagementViewModel{OrganizationName: "Tenant Root Group", UpdateTime: previousUpdateTime},
+			want: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := isAzureOrganizationUpdated(&tc.resp, previousUpdateTime, tc.req); got != tc.want {
+				t.Errorf("got %t, want %t", got, tc.want)
+			}
+		})
+	}
+}
@@ -7,7 +7,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 
-	"github.com/dome9/dome9-sdk-go/dome9/client"
 	"github.com/dome9/dome9-sdk-go/services/cloudsecuritygroup/securitygroupaws"
 
 	"github.com/terraform-providers/terraform-provider-dome9/dome9/common/providerconst"
@@ -213,7 +212,7 @@ func resourceCloudSecurityGroupAWSRead(d *schema.ResourceData, meta interface{})
 	d9Client := meta.(*Client)
 	resp, _, err := d9Client.awsSecurityGroup.Get(d.Id())
 	if err != nil {
-		if err.(*client.ErrorResponse).IsObjectNotFound() {
+		if isNotFoundError(err) {
 			log.Printf("[WARN] Removing AWS cloud account security group %s from state because it no longer exists in Dome9", d.Id())
 			d.SetId("")
 			return nil
@@ -7,7 +7,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 
-	"github.com/dome9/dome9-sdk-go/dome9/client"
 	"github.com/dome9/dome9-sdk-go/services/cloudsecuritygroup/securitygroupaws"
 
 	"github.com/terraform-providers/terraform-provider-dome9/dome9/common/providerconst"
@@ -165,7 +164,7 @@ func resourceCloudSecurityGroupAWSRuleRead(d *schema.ResourceData, meta interfac
 	d9Client := meta.(*Client)
 	resp, _, err := d9Client.awsSecurityGroup.Get(d.Id())
 	if err != nil {
-		if err.(*client.ErrorResponse).IsObjectNotFound() {
+		if isNotFoundError(err) {
 			log.Printf("[WARN] Removing AWS cloud account security group %s from state because it no longer exists in Dome9", d.Id())
 			d.SetId("")
 			return nil
@@ -175,7 +174,7 @@ func resourceCloudSecurityGroupAWSRuleRead(d *schema.ResourceData, meta interfac
 	}
 
 	log.Printf("[INFO] Getting AWS cloud account security group:\n%+v\n", resp)
-	_ = d.Set("dome9_security_group_id", resp.ID)
+	_ = d.Set("dome9_security_group_id", strconv.Itoa(resp.ID))
 
 	if err := d.Set("services", flattenCloudSecurityGroupAWSServices(resp.Services)); err != nil {
 		return err
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
 
 	"github.com/terraform-providers/terraform-provider-dome9/dome9/common/resourcetype"
 	"github.com/terraform-providers/terraform-provider-dome9/dome9/common/testing/environmentvariable"
@@ -22,10 +23,11 @@ func TestAccResourceCloudSecurityGroupAWSRuleBasic(t *testing.T) {
 	awsSecurityGroupHCL := getCloudAccountSecurityGroupAWSResourceHCL(securityGroupGeneratedName, securityGroupGeneratedName, awsTypeAndName, "")
 
 	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
 		Providers: testAccProviders,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccCheckCloudSecurityGroupAWSRuleBasic(awsCloudAccountHCL, awsSecurityGroupHCL, securityGroupTypeAndName, securityGroupRuleGeneratedName),
+				Config: testAccCheckCloudSecurityGroupAWSRuleBasic(awsCloudAccountHCL, awsSecurityGroupHCL, securityGroupTypeAndName, securityGroupRuleGeneratedName, variable.AWSSecurityGroupRuleDescription),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(securityGroupRuleTypeAndName, "services.#", "1"),
 				),
@@ -34,7 +36,61 @@ func TestAccResourceCloudSecurityGroupAWSRuleBasic(t *testing.T) {
 	})
 }
 
-func testAccCheckCloudSecurityGroupAWSRuleBasic(awsCloudAccountHCL, awsSecurityGroupHCL, securityGroupTypeAndName, securityGroupRuleGeneratedName string) string {
+func TestResourceCloudSecurityGroupAWSRuleLifecycle(t *testing.T) {
+	api := newFakeDome9API(t)
+	securityGroupTypeAndName, _, securityGroupGeneratedName := method.GenerateRandomSourcesTypeAndName(resourcetype.CloudAccountAWSSecurityGroup)
+	securityGroupRuleTypeAndName, _, securityGroupRuleGeneratedName := method.GenerateRandomSourcesTypeAndName(resourcetype.CloudAccountAWSSecurityGroupRule)
+	awsTypeAndName, _, awsGeneratedName := method.GenerateRandomSourcesTypeAndName(resourcetype.CloudAccountAWS)
+	t.Setenv(environmentvariable.CloudAccountAWSEnvVarSecret, "secret")
+	awsCloudAccountHCL := getCloudAccountAWSResourceHCL(awsGeneratedName, variable.CloudAccountAWSOriginalAccountName, "arn:aws:iam::123456789012:role/dome9", "")
+	awsSecurityGroupHCL := getCloudAccountSecurityGroupAWSResourceHCL(securityGroupGeneratedName, securityGroupGeneratedName, awsTypeAndName, "")
+	updateConfig := testAccCheckCloudSecurityGroupAWSRuleBasic(awsCloudAccountHCL, awsSecurityGroupHCL, securityGroupTypeAndName, securityGroupRuleGeneratedName, variable.AWSSecurityGroupRuleUpdatedDescription)
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: api.providers(),
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			api.checkDestroyed("CloudSecurityGroup"),
+			api.checkDestroyed("cloudaccounts"),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: api.config(testAccCheckCloudSecurityGroupAWSRuleBasic(awsCloudAccountHCL, awsSecurityGroupHCL, securityGroupTypeAndName, securityGroupRuleGeneratedName, variable.AWSSecurityGroupRuleDescription)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(securityGroupRuleTypeAndName, "services.#", "1"),
+					resource.TestCheckResourceAttrPair(securityGroupRuleTypeAndName, "dome9_security_group_id", securityGroupTypeAndName, "id"),
+					testCheckSecurityGroupAWSInboundDescription(api, securityGroupTypeAndName, variable.AWSSecurityGroupRuleDescription),
+				),
+			},
+			{
+				Config: api.config(updateConfig),
+				Check:  testCheckSecurityGroupAWSInboundDescription(api, securityGroupTypeAndName, variable.AWSSecurityGroupRuleUpdatedDescription),
+			},
+			api.importStep(updateConfig, securityGroupRuleTypeAndName),
+		},
+	})
+}
+
+// testCheckSecurityGroupAWSInboundDescription checks the description of the only inbound service of the security group in the fake API
+func testCheckSecurityGroupAWSInboundDescription(api *fakeAPI, securityGroupTypeAndName, description string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		id := s.RootModule().Resources[securityGroupTypeAndName].Primary.ID
+		api.mu.Lock()
+		defer api.mu.Unlock()
+
+		services, _ := api.collection("CloudSecurityGroup").objects[id]["services"].(map[string]interface{})
+		inbound, _ := services["inbound"].([]interface{})
+		if len(inbound) != 1 {
+			return fmt.Errorf("security group %s has %d inbound services, want 1", id, len(inbound))
+		}
+		if got := inbound[0].(map[string]interface{})["description"]; got != description {
+			return fmt.Errorf("inbound service description is %v, want %s", got, description)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckCloudSecurityGroupAWSRuleBasic(awsCloudAccountHCL, awsSecurityGroupHCL, securityGroupTypeAndName, securityGroupRuleGeneratedName, description string) string {
 	return fmt.Sprintf(`
 // aws cloud account resource
 %s
@@ -47,7 +103,7 @@ resource "%s" "%s" {
   services {
     inbound {
       name          = "inbound-test-aws-sg-rule"
-      description   = "inbound-test-aws-sg-rule"
+      description   = "%s"
       protocol_type = "ALL"
       port          = ""
       open_for_all  = true
@@ -69,5 +125,6 @@ resource "%s" "%s" {
 		resourcetype.CloudAccountAWSSecurityGroupRule,
 		securityGroupRuleGeneratedName,
 		securityGroupTypeAndName,
+		description,
 	)
 }
@@ -53,6 +53,41 @@ func TestAccResourceCloudSecurityGroupAWSBasic(t *testing.T) {
 	})
 }
 
+func TestResourceCloudSecurityGroupAWSLifecycle(t *testing.T) {
+	api := newFakeDome9API(t)
+	securityGroupTypeAndName, _, securityGroupGeneratedName := method.GenerateRandomSourcesTypeAndName(resourcetype.CloudAccountAWSSecurityGroup)
+	awsTypeAndName, _, awsGeneratedName := method.GenerateRandomSourcesTypeAndName(resourcetype.CloudAccountAWS)
+	t.Setenv(environmentvariable.CloudAccountAWSEnvVarSecret, "secret")
+	awsCloudAccountHCL := getCloudAccountAWSResourceHCL(awsGeneratedName, variable.CloudAccountAWSOriginalAccountName, "arn:aws:iam::123456789012:role/dome9", "")
+	updateConfig := testAccCheckCloudSecurityGroupAWSUpdateBasic(awsCloudAccountHCL, awsTypeAndName, securityGroupGeneratedName)
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: api.providers(),
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			api.checkDestroyed("CloudSecurityGroup"),
+			api.checkDestroyed("cloudaccounts"),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: api.config(testAccCheckCloudSecurityGroupAWSBasic(awsCloudAccountHCL, awsTypeAndName, securityGroupGeneratedName, securityGroupTypeAndName)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(securityGroupTypeAndName, "dome9_security_group_name", securityGroupGeneratedName),
+					resource.TestCheckResourceAttr(securityGroupTypeAndName, "description", variable.AWSSecurityGroupDescription),
+					resource.TestCheckResourceAttr(securityGroupTypeAndName, "aws_region_id", variable.AWSSecurityGroupRegionID),
+					resource.TestCheckResourceAttr(securityGroupTypeAndName, "is_protected", "true"),
+					resource.TestCheckResourceAttrPair("data."+securityGroupTypeAndName, "external_id", securityGroupTypeAndName, "external_id"),
+				),
+			},
+			{
+				Config: api.config(updateConfig),
+				Check:  resource.TestCheckResourceAttr(securityGroupTypeAndName, "tags.key", variable.AWSSecurityGroupTagValue),
+			},
+			// Read does not set the cloud account of the security group
+			api.importStep(updateConfig, securityGroupTypeAndName, "dome9_cloud_account_id"),
+		},
+	})
+}
+
 func testAccCheckCloudSecurityGroupAWSExists(resource string, securityGroup *securitygroupaws.CloudSecurityGroupResponse) resource.TestCheckFunc {
 	return func(state *terraform.State) error {
 		rs, ok := state.RootModule().Resources[resource]
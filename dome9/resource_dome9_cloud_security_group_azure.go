@@ -6,7 +6,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 
-	"github.com/dome9/dome9-sdk-go/dome9/client"
 	"github.com/dome9/dome9-sdk-go/services/cloudsecuritygroup/securitygroupazure"
 
 	"github.com/terraform-providers/terraform-provider-dome9/dome9/common/providerconst"
@@ -279,7 +278,7 @@ func resourceSecurityGroupAzureRead(d *schema.ResourceData, meta interface{}) er
 	d9Client := meta.(*Client)
 	resp, _, err := d9Client.azureSecurityGroup.Get(d.Id())
 	if err != nil {
-		if err.(*client.ErrorResponse).IsObjectNotFound() {
+		if isNotFoundError(err) {
 			log.Printf("[WARN] Removing Azure security group %s from state because it no longer exists in Dome9", d.Id())
 			d.SetId("")
 			return nil
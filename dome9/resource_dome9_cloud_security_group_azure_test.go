@@ -59,6 +59,48 @@ func TestAccResourceAzureSecurityGroupBasic(t *testing.T) {
 	})
 }
 
+func TestResourceAzureSecurityGroupLifecycle(t *testing.T) {
+	api := newFakeDome9API(t)
+	t.Setenv(environmentvariable.CloudAccountAzureEnvVarClientId, "11111111-1111-1111-1111-111111111111")
+	t.Setenv(environmentvariable.CloudAccountAzureEnvVarClientPassword, "client-password")
+	t.Setenv(environmentvariable.CloudAccountAzureEnvVarSubscriptionId, "22222222-2222-2222-2222-222222222222")
+	t.Setenv(environmentvariable.CloudAccountAzureEnvVarTenantId, "33333333-3333-3333-3333-333333333333")
+	t.Setenv(environmentvariable.AzureSecurityGroupResourceGroup, "resource-group")
+	securityGroupTypeAndName, _, securityGroupGeneratedName := method.GenerateRandomSourcesTypeAndName(resourcetype.CloudAccountAzureSecurityGroup)
+	azureTypeAndName, _, azureGeneratedName := method.GenerateRandomSourcesTypeAndName(resourcetype.CloudAccountAzure)
+	azureCloudAccountHCL := getCloudAccountAzureResourceHCL(azureGeneratedName, variable.CloudAccountAzureCreationResourceName, variable.CloudAccountAzureUpdateOperationMode)
+	updateConfig := testAccCheckAzureSecurityGroupBasic(azureCloudAccountHCL, azureTypeAndName, securityGroupGeneratedName, securityGroupTypeAndName, azureSecurityGroupUpdateAdditionalBlock())
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: api.providers(),
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			api.checkDestroyed("AzureSecurityGroupPolicy"),
+			api.checkDestroyed("AzureCloudAccount"),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: api.config(testAccCheckAzureSecurityGroupBasic(azureCloudAccountHCL, azureTypeAndName, securityGroupGeneratedName, securityGroupTypeAndName, azureSecurityGroupAdditionalBlock())),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(securityGroupTypeAndName, "dome9_security_group_name", securityGroupGeneratedName),
+					resource.TestCheckResourceAttr(securityGroupTypeAndName, "description", variable.AzureSecurityGroupDescription),
+					resource.TestCheckResourceAttr(securityGroupTypeAndName, "is_tamper_protected", strconv.FormatBool(variable.AzureSecurityGroupIsTamperProtected)),
+					resource.TestCheckResourceAttr(securityGroupTypeAndName, "tags.0.value", variable.AzureSecurityGroupTagValue),
+					resource.TestCheckResourceAttrPair("data."+securityGroupTypeAndName, "external_security_group_id", securityGroupTypeAndName, "external_security_group_id"),
+				),
+			},
+			{
+				Config: api.config(updateConfig),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(securityGroupTypeAndName, "description", variable.AzureSecurityGroupUpdateDescription),
+					resource.TestCheckResourceAttr(securityGroupTypeAndName, "is_tamper_protected", strconv.FormatBool(variable.AzureSecurityGroupUpdateIsTamperProtected)),
+					resource.TestCheckResourceAttr(securityGroupTypeAndName, "tags.0.value", variable.AzureSecurityGroupUpdateTagValue),
+				),
+			},
+			api.importStep(updateConfig, securityGroupTypeAndName),
+		},
+	})
+}
+
 func testAccCheckAzureSecurityGroupExists(resource string, securityGroup *securitygroupazure.AzureSecurityGroupResponse) resource.TestCheckFunc {
 	return func(state *terraform.State) error {
 		rs, ok := state.RootModule().Resources[resource]
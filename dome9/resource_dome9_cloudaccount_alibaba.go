@@ -4,8 +4,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"log"
 
-	"github.com/dome9/dome9-sdk-go/dome9/client"
 	"github.com/dome9/dome9-sdk-go/services/cloudaccounts/alibaba"
+
+	"github.com/terraform-providers/terraform-provider-dome9/dome9/common/resourcetype"
 )
 
 func resourceCloudAccountAlibaba() *schema.Resource {
@@ -14,9 +15,11 @@ func resourceCloudAccountAlibaba() *schema.Resource {
 		Read:   resourceCloudAccountAlibabaRead,
 		Update: resourceCloudAccountAlibabaUpdate,
 		Delete: resourceCloudAccountAlibabaDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Importer: alibabaCloudAccountLookup.importer(),
+		CustomizeDiff: customizeCloudAccountDiff(
+			cloudAccountCheck{key: "credentials.access_key", pattern: nonEmptyPattern, expected: "the AccessKey ID of the Dome9 RAM user"},
+			cloudAccountCheck{key: "credentials.access_secret", pattern: nonEmptyPattern, expected: "the AccessKey secret of the Dome9 RAM user", sensitive: true},
+		),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -64,6 +67,7 @@ func resourceCloudAccountAlibaba() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"deletion_protection": deletionProtectionSchema(),
 		},
 	}
 }
@@ -89,7 +93,7 @@ func resourceCloudAccountAlibabaRead(d *schema.ResourceData, meta interface{}) e
 	resp, _, err := d9Client.cloudaccountAlibaba.Get(d.Id())
 
 	if err != nil {
-		if err.(*client.ErrorResponse).IsObjectNotFound() {
+		if isNotFoundError(err) {
 			log.Printf("[WARN] Removing Alibaba cloud account %s from state because it no longer exists in Dome9", d.Id())
 			d.SetId("")
 			return nil
@@ -107,10 +111,16 @@ func resourceCloudAccountAlibabaRead(d *schema.ResourceData, meta interface{}) e
 	_ = d.Set("organizational_unit_path", resp.OrganizationalUnitPath)
 	_ = d.Set("organizational_unit_name", resp.OrganizationalUnitName)
 
+	setMissingDefaults(d, map[string]interface{}{"deletion_protection": false})
+
 	return nil
 }
 
 func resourceCloudAccountAlibabaDelete(d *schema.ResourceData, meta interface{}) error {
+	if err := checkDeletionProtection(d, resourcetype.CloudAccountAlibaba); err != nil {
+		return err
+	}
+
 	d9Client := meta.(*Client)
 	log.Printf("[INFO] Deleting Alibaba Cloud Account ID: %v\n", d.Id())
 	if _, err := d9Client.cloudaccountAlibaba.Delete(d.Id()); err != nil {
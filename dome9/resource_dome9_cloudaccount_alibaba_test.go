@@ -50,6 +50,47 @@ func TestAccResourceCloudAccountAlibabaBasic(t *testing.T) {
 	})
 }
 
+func TestResourceCloudAccountAlibabaLifecycle(t *testing.T) {
+	api := newFakeDome9API(t)
+	t.Setenv(environmentvariable.CloudAccountAlibabaEnvVarAccessKey, "access-key")
+	t.Setenv(environmentvariable.CloudAccountAlibabaEnvVarAccessSecret, "access-secret")
+	resourceTypeAndName, _, generatedName := method.GenerateRandomSourcesTypeAndName(resourcetype.CloudAccountAlibaba)
+	createConfig := testAccCheckCloudAccountAlibabaConfigure(resourceTypeAndName, generatedName, variable.CloudAccountAlibabaCreationResourceName)
+	t.Setenv(environmentvariable.CloudAccountAlibabaEnvVarAccessSecret, "rotated-access-secret")
+	updateConfig := getCloudAccountAlibabaResourceHCL(generatedName, variable.CloudAccountAlibabaUpdatedAccountName)
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    api.providers(),
+		CheckDestroy: api.checkDestroyed("AlibabaCloudAccount"),
+		Steps: []resource.TestStep{
+			{
+				Config: api.config(createConfig),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceTypeAndName, "name", variable.CloudAccountAlibabaCreationResourceName),
+					resource.TestCheckResourceAttr(resourceTypeAndName, "vendor", variable.CloudAccountAlibabaVendor),
+					resource.TestCheckResourceAttrSet(resourceTypeAndName, "alibaba_account_id"),
+					resource.TestCheckResourceAttrPair("data."+resourceTypeAndName, "name", resourceTypeAndName, "name"),
+				),
+			},
+			{
+				Config: api.config(updateConfig),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceTypeAndName, "name", variable.CloudAccountAlibabaUpdatedAccountName),
+					func(s *terraform.State) error {
+						id := s.RootModule().Resources[resourceTypeAndName].Primary.ID
+						if !api.requested("PUT /v2/AlibabaCloudAccount/" + id + "/Credentials") {
+							return fmt.Errorf("the credentials were not updated")
+						}
+						return nil
+					},
+				),
+			},
+			// the API never returns the credentials
+			api.importStep(updateConfig, resourceTypeAndName, "credentials"),
+		},
+	})
+}
+
 func testAccCheckCloudAccountAlibabaDestroy(s *terraform.State) error {
 	apiClient := testAccProvider.Meta().(*Client)
 
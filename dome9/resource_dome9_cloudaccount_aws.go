@@ -2,14 +2,15 @@ package dome9
 
 import (
 	"fmt"
-	"github.com/dome9/dome9-sdk-go/dome9/client"
 	"github.com/dome9/dome9-sdk-go/services/cloudaccounts"
 	"github.com/dome9/dome9-sdk-go/services/cloudaccounts/aws"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"log"
+	"strings"
 
 	"github.com/terraform-providers/terraform-provider-dome9/dome9/common/providerconst"
+	"github.com/terraform-providers/terraform-provider-dome9/dome9/common/resourcetype"
 )
 
 func resourceCloudAccountAWS() *schema.Resource {
@@ -18,9 +19,8 @@ func resourceCloudAccountAWS() *schema.Resource {
 		Read:   resourceCloudAccountAWSRead,
 		Update: resourceCloudAccountAWSUpdate,
 		Delete: resourceCloudAccountAWSDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Importer: awsCloudAccountLookup.importer(),
+		CustomizeDiff: resourceCloudAccountAWSCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -170,6 +170,13 @@ func resourceCloudAccountAWS() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"deletion_protection": deletionProtectionSchema(),
+			"force_delete": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "offboard the account with the force delete API, which also removes the account from the Dome9 features using it",
+			},
 		},
 	}
 }
@@ -199,7 +206,7 @@ func resourceCloudAccountAWSRead(d *schema.ResourceData, meta interface{}) error
 	resp, _, err := d9Client.cloudaccountAWS.Get(&getCloudAccountQueryParams)
 
 	if err != nil {
-		if err.(*client.ErrorResponse).IsObjectNotFound() {
+		if isNotFoundError(err) {
 			log.Printf("[WARN] Removing AWS cloud account %s from state because it no longer exists in Dome9", d.Id())
 			d.SetId("")
 			return nil
@@ -229,13 +236,27 @@ func resourceCloudAccountAWSRead(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
+	setMissingDefaults(d, map[string]interface{}{"deletion_protection": false, "force_delete": true})
+
 	return nil
 }
 
 func resourceCloudAccountAWSDelete(d *schema.ResourceData, meta interface{}) error {
+	if err := checkDeletionProtection(d, resourcetype.CloudAccountAWS); err != nil {
+		return err
+	}
+
 	d9Client := meta.(*Client)
-	log.Printf("[INFO] Deleting AWS Cloud Account ID: %v\n", d.Id())
+	if !d.Get("force_delete").(bool) {
+		log.Printf("[INFO] Deleting AWS Cloud Account ID: %v\n", d.Id())
+		if _, err := d9Client.cloudaccountAWS.Delete(d.Id()); err != nil {
+			return err
+		}
+
+		return nil
+	}
 
+	log.Printf("[INFO] Force deleting AWS Cloud Account ID: %v\n", d.Id())
 	if _, err := d9Client.cloudaccountAWS.ForceDelete(d.Id()); err != nil {
 		return err
 	}
@@ -361,57 +382,123 @@ func validateVendor(vendor string, credentials aws.CloudAccountCredentials, regi
 	return nil
 }
 
-func validateAwsGovVendor(credentials aws.CloudAccountCredentials, regions []string) (bool, error) {
-	validate := checkRegions(regions, providerconst.AWSGOVRegions)
+// awsVendor holds the onboarding type and the regions of an AWS partition
+type awsVendor struct {
+	name           string
+	onboardingType string
+	regions        []string
+}
 
-	if !validate {
-		return validate, fmt.Errorf("awsGov vendor has an unsutibule regions")
-	}
+var (
+	awsCommercialVendor = awsVendor{name: "aws", onboardingType: "RoleBased", regions: providerconst.AWSRegions}
+	awsGovVendor        = awsVendor{name: "awsGov", onboardingType: "UserBased", regions: providerconst.AWSGOVRegions}
+	awsChinaVendor      = awsVendor{name: "awsChina", onboardingType: "UserBased", regions: providerconst.AWSChinaRegions}
+)
 
-	if credentials.Type != "UserBased" || len(credentials.ApiKey) == 0 || len(credentials.Secret) == 0 {
-		return false, fmt.Errorf("awsGov vendor has wrong credentials")
-	}
-	return true, nil
+// awsVendors maps the vendor argument of dome9_cloudaccount_aws to its partition
+var awsVendors = map[string]awsVendor{
+	"aws":      awsCommercialVendor,
+	"awsgov":   awsGovVendor,
+	"awschina": awsChinaVendor,
+}
+
+func validateAwsGovVendor(credentials aws.CloudAccountCredentials, regions []string) (bool, error) {
+	return validateAwsPartition(awsGovVendor, credentials, regions)
 }
 
 func validateAwsVendor(credentials aws.CloudAccountCredentials, regions []string) (bool, error) {
-	validate := checkRegions(regions, providerconst.AWSRegions)
-	if !validate {
-		return validate, fmt.Errorf("aws vendor has an unsutibule regions")
-	}
-	if credentials.Type != "RoleBased" || len(credentials.Arn) == 0 || len(credentials.Secret) == 0 {
-		return false, fmt.Errorf("aws vendor has wrong credentials")
-	}
-	return true, nil
+	return validateAwsPartition(awsCommercialVendor, credentials, regions)
 }
 
 func validateAwsChinaVendor(credentials aws.CloudAccountCredentials, regions []string) (bool, error) {
-	validate := checkRegions(regions, providerconst.AWSChinaRegions)
+	return validateAwsPartition(awsChinaVendor, credentials, regions)
+}
 
-	if !validate {
-		return validate, fmt.Errorf("awsChina vendor has an unsutibule regions")
+func validateAwsPartition(vendor awsVendor, credentials aws.CloudAccountCredentials, regions []string) (bool, error) {
+	if err := validateAwsRegions(vendor, regions); err != nil {
+		return false, err
 	}
-
-	if credentials.Type != "UserBased" || len(credentials.ApiKey) == 0 || len(credentials.Secret) == 0 {
-		return false, fmt.Errorf("awsChina vendor has wrong credentials")
+	if err := validateAwsCredentials(vendor, credentials); err != nil {
+		return false, err
 	}
 
 	return true, nil
 }
 
-func checkRegions(regions []string, regionsToCompare []string) bool {
-	for _, val := range regions {
-		flag := false
-		for _, region := range regionsToCompare {
-			if val == region {
-				flag = true
+// validateAwsRegions returns an error naming the first region of net_sec out of the partition of the vendor, the empty
+// regions being the ones known after apply
+func validateAwsRegions(vendor awsVendor, regions []string) error {
+	for i, region := range regions {
+		if region == "" {
+			continue
+		}
+		supported := false
+		for _, r := range vendor.regions {
+			if region == r {
+				supported = true
 			}
 		}
-		if !flag {
-			return false
+		if !supported {
+			return fmt.Errorf("net_sec.0.regions.%d.region: %s vendor doesn't support region %s, the supported regions are %s",
+				i, vendor.name, region, strings.Join(vendor.regions, ", "))
 		}
 	}
-	return true
+
+	return nil
+}
+
+func validateAwsCredentials(vendor awsVendor, credentials aws.CloudAccountCredentials) error {
+	if credentials.Type != vendor.onboardingType {
+		return fmt.Errorf("credentials.0.type: %s vendor requires the %s onboarding type, got %q", vendor.name, vendor.onboardingType, credentials.Type)
+	}
+	if vendor.onboardingType == "RoleBased" && len(credentials.Arn) == 0 {
+		return fmt.Errorf("credentials.0.arn: the ARN of the Dome9 role is required by the RoleBased onboarding type")
+	}
+	if vendor.onboardingType == "UserBased" && len(credentials.ApiKey) == 0 {
+		return fmt.Errorf("credentials.0.api_key: the access key of the Dome9 user is required by the UserBased onboarding type")
+	}
+	if len(credentials.Secret) == 0 {
+		return fmt.Errorf("credentials.0.secret: the %s onboarding type requires a secret", vendor.onboardingType)
+	}
+
+	return nil
+}
+
+// resourceCloudAccountAWSCustomizeDiff validates the regions and the credentials against the vendor at plan time, as
+// expandCloudAccountAWSRequest does on create. The values known after apply are not checked, nor are the unchanged
+// arguments of existing accounts.
+func resourceCloudAccountAWSCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("vendor") {
+		return nil
+	}
+	vendor, ok := awsVendors[d.Get("vendor").(string)]
+	if !ok {
+		return nil
+	}
+	newAccount := d.Id() == ""
+
+	if (newAccount || d.HasChange("vendor") || d.HasChange("net_sec")) && d.NewValueKnown("net_sec") {
+		var regions []string
+		for i := range d.Get("net_sec.0.regions").([]interface{}) {
+			region, _ := plannedString(d, fmt.Sprintf("net_sec.0.regions.%d.region", i))
+			regions = append(regions, region)
+		}
+		if err := validateAwsRegions(vendor, regions); err != nil {
+			return err
+		}
+	}
+
+	if (newAccount || d.HasChange("vendor") || d.HasChange("credentials")) && d.NewValueKnown("credentials.0.type") {
+		credentials := aws.CloudAccountCredentials{Type: d.Get("credentials.0.type").(string)}
+		credentials.Arn = plannedStringOrPlaceholder(d, "credentials.0.arn")
+		credentials.ApiKey = plannedStringOrPlaceholder(d, "credentials.0.api_key")
+		credentials.Secret = plannedStringOrPlaceholder(d, "credentials.0.secret")
+		if err := validateAwsCredentials(vendor, credentials); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 func expandCloudAccountAWSCredentials(d *schema.ResourceData) aws.CloudAccountCredentials {
@@ -66,6 +66,52 @@ func TestAccResourceCloudAccountAWSBasic(t *testing.T) {
 	})
 }
 
+func TestResourceCloudAccountAWSLifecycle(t *testing.T) {
+	api := newFakeDome9API(t)
+	resourceTypeAndName, _, generatedName := method.GenerateRandomSourcesTypeAndName(resourcetype.CloudAccountAWS)
+	originalArn := "arn:aws:iam::123456789012:role/dome9"
+	updatedArn := "arn:aws:iam::123456789012:role/dome9-updated"
+	updatedGroupBehavior := variable.CloudAccountAWSFullManageGroupBehavior
+	t.Setenv(environmentvariable.CloudAccountAWSEnvVarSecret, "secret")
+	updateConfig := getCloudAccountAWSResourceHCL(generatedName, variable.CloudAccountAWSUpdatedAccountName, updatedArn, testAccCloudAccountAWSNetsecConfig(updatedGroupBehavior))
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    api.providers(),
+		CheckDestroy: api.checkDestroyed("cloudaccounts"),
+		Steps: []resource.TestStep{
+			{
+				Config: api.config(testAccCheckCloudAccountAWSBasic(resourceTypeAndName, generatedName, variable.CloudAccountAWSOriginalAccountName, originalArn, "")),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceTypeAndName, "vendor", variable.CloudAccountAWSVendor),
+					resource.TestCheckResourceAttr(resourceTypeAndName, "name", variable.CloudAccountAWSOriginalAccountName),
+					resource.TestCheckResourceAttr(resourceTypeAndName, "credentials.0.arn", originalArn),
+					resource.TestCheckResourceAttr(resourceTypeAndName, "net_sec.0.regions.#", fmt.Sprint(len(providerconst.AWSRegions))),
+					resource.TestCheckResourceAttr(resourceTypeAndName, "net_sec.0.regions.0.new_group_behavior", variable.CloudAccountAWSReadOnlyGroupBehavior),
+					resource.TestCheckResourceAttrPair("data."+resourceTypeAndName, "name", resourceTypeAndName, "name"),
+				),
+			},
+			{
+				Config: api.config(updateConfig),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceTypeAndName, "name", variable.CloudAccountAWSUpdatedAccountName),
+					resource.TestCheckResourceAttr(resourceTypeAndName, "credentials.0.arn", updatedArn),
+					resource.TestCheckResourceAttr(resourceTypeAndName, "net_sec.0.regions.0.new_group_behavior", updatedGroupBehavior),
+					resource.TestCheckResourceAttr(resourceTypeAndName, "net_sec.0.regions.1.new_group_behavior", updatedGroupBehavior),
+					resource.TestCheckResourceAttr(resourceTypeAndName, "net_sec.0.regions.2.new_group_behavior", variable.CloudAccountAWSReadOnlyGroupBehavior),
+					func(*terraform.State) error {
+						if !api.requested("PUT /v2/cloudaccounts/credentials") {
+							return fmt.Errorf("the credentials were not updated")
+						}
+						return nil
+					},
+				),
+			},
+			// the API never returns the credentials
+			api.importStep(updateConfig, resourceTypeAndName, "credentials"),
+		},
+	})
+}
+
 func testAccCheckCloudAccountAWSExists(resource string, cloudAccount *aws.CloudAccountResponse) resource.TestCheckFunc {
 	return func(state *terraform.State) error {
 		rs, ok := state.RootModule().Resources[resource]
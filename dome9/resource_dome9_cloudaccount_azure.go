@@ -7,10 +7,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 
-	"github.com/dome9/dome9-sdk-go/dome9/client"
 	"github.com/dome9/dome9-sdk-go/services/cloudaccounts"
 	"github.com/dome9/dome9-sdk-go/services/cloudaccounts/azure"
 	"github.com/terraform-providers/terraform-provider-dome9/dome9/common/providerconst"
+	"github.com/terraform-providers/terraform-provider-dome9/dome9/common/resourcetype"
 )
 
 func resourceCloudAccountAzure() *schema.Resource {
@@ -19,9 +19,12 @@ func resourceCloudAccountAzure() *schema.Resource {
 		Read:   resourceCloudAccountAzureRead,
 		Update: resourceCloudAccountAzureUpdate,
 		Delete: resourceCloudAccountAzureDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Importer: azureCloudAccountLookup.importer(),
+		CustomizeDiff: customizeCloudAccountDiff(
+			cloudAccountCheck{key: "subscription_id", pattern: uuidPattern, expected: "the UUID of the subscription"},
+			cloudAccountCheck{key: "tenant_id", pattern: uuidPattern, expected: "the UUID of the Azure AD tenant"},
+			cloudAccountCheck{key: "client_id", pattern: uuidPattern, expected: "the application (client) UUID of the Dome9 app registration"},
+		),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -74,6 +77,7 @@ func resourceCloudAccountAzure() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"deletion_protection": deletionProtectionSchema(),
 		},
 	}
 }
@@ -100,7 +104,7 @@ func resourceCloudAccountAzureRead(d *schema.ResourceData, meta interface{}) err
 	resp, _, err := d9Client.cloudaccountAzure.Get(&getCloudAccountQueryParams)
 
 	if err != nil {
-		if err.(*client.ErrorResponse).IsObjectNotFound() {
+		if isNotFoundError(err) {
 			log.Printf("[WARN] Removing Azure cloud account %s from state because it no longer exists in Dome9", d.Id())
 			d.SetId("")
 			return nil
@@ -120,10 +124,16 @@ func resourceCloudAccountAzureRead(d *schema.ResourceData, meta interface{}) err
 	_ = d.Set("organizational_unit_path", resp.OrganizationalUnitPath)
 	_ = d.Set("organizational_unit_name", resp.OrganizationalUnitName)
 
+	setMissingDefaults(d, map[string]interface{}{"deletion_protection": false})
+
 	return nil
 }
 
 func resourceCloudAccountAzureDelete(d *schema.ResourceData, meta interface{}) error {
+	if err := checkDeletionProtection(d, resourcetype.CloudAccountAzure); err != nil {
+		return err
+	}
+
 	d9Client := meta.(*Client)
 	log.Printf("[INFO] Deleting Azure Cloud Account ID: %v\n", d.Id())
 	if _, err := d9Client.cloudaccountAzure.Delete(d.Id()); err != nil {
@@ -158,3 +158,37 @@ resource "%s" "%s" {
 		os.Getenv(environmentvariable.CloudAccountAzureEnvVarTenantId),
 	)
 }
+
+func TestResourceCloudAccountAzureLifecycle(t *testing.T) {
+	api := newFakeDome9API(t)
+	t.Setenv(environmentvariable.CloudAccountAzureEnvVarClientId, "11111111-1111-1111-1111-111111111111")
+	t.Setenv(environmentvariable.CloudAccountAzureEnvVarClientPassword, "client-password")
+	t.Setenv(environmentvariable.CloudAccountAzureEnvVarSubscriptionId, "22222222-2222-2222-2222-222222222222")
+	t.Setenv(environmentvariable.CloudAccountAzureEnvVarTenantId, "33333333-3333-3333-3333-333333333333")
+	resourceTypeAndName, _, generatedName := method.GenerateRandomSourcesTypeAndName(resourcetype.CloudAccountAzure)
+	updateConfig := getCloudAccountAzureResourceHCL(generatedName, variable.CloudAccountAzureUpdatedAccountName, variable.CloudAccountAzureUpdateOperationMode)
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    api.providers(),
+		CheckDestroy: api.checkDestroyed("AzureCloudAccount"),
+		Steps: []resource.TestStep{
+			{
+				Config: api.config(testAccCheckCloudAccountAzureConfigure(resourceTypeAndName, generatedName, variable.CloudAccountAzureCreationResourceName, variable.CloudAccountAzureOperationMode)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceTypeAndName, "name", variable.CloudAccountAzureCreationResourceName),
+					resource.TestCheckResourceAttr(resourceTypeAndName, "operation_mode", variable.CloudAccountAzureOperationMode),
+					resource.TestCheckResourceAttr(resourceTypeAndName, "vendor", variable.CloudAccountAzureVendor),
+				),
+			},
+			{
+				Config: api.config(updateConfig),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceTypeAndName, "name", variable.CloudAccountAzureUpdatedAccountName),
+					resource.TestCheckResourceAttr(resourceTypeAndName, "operation_mode", variable.CloudAccountAzureUpdateOperationMode),
+				),
+			},
+			// the API never returns the credentials
+			api.importStep(updateConfig, resourceTypeAndName, "client_id", "client_password"),
+		},
+	})
+}
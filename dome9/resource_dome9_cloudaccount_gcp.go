@@ -3,12 +3,12 @@ package dome9
 import (
 	"log"
 
-	"github.com/dome9/dome9-sdk-go/dome9/client"
 	"github.com/dome9/dome9-sdk-go/services/cloudaccounts"
 	"github.com/dome9/dome9-sdk-go/services/cloudaccounts/gcp"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 
 	"github.com/terraform-providers/terraform-provider-dome9/dome9/common/providerconst"
+	"github.com/terraform-providers/terraform-provider-dome9/dome9/common/resourcetype"
 )
 
 func resourceCloudAccountGCP() *schema.Resource {
@@ -17,9 +17,12 @@ func resourceCloudAccountGCP() *schema.Resource {
 		Read:   resourceCloudAccountGCPRead,
 		Update: resourceCloudAccountGCPUpdate,
 		Delete: resourceCloudAccountGCPDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Importer: gcpCloudAccountLookup.importer(),
+		CustomizeDiff: customizeCloudAccountDiff(
+			cloudAccountCheck{key: "private_key", pattern: pemPrivateKeyPattern, expected: "the PEM private key of the service account key file", sensitive: true},
+			cloudAccountCheck{key: "client_email", pattern: serviceAccountPattern, expected: "the email of a service account"},
+			cloudAccountCheck{key: "client_x509_cert_url", pattern: httpsURLPattern, expected: "an https URL"},
+		),
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -75,6 +78,7 @@ func resourceCloudAccountGCP() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"deletion_protection": deletionProtectionSchema(),
 		},
 	}
 }
@@ -100,7 +104,7 @@ func resourceCloudAccountGCPRead(d *schema.ResourceData, meta interface{}) error
 	resp, _, err := d9Client.cloudaccountGCP.Get(&getCloudAccountQueryParams)
 
 	if err != nil {
-		if err.(*client.ErrorResponse).IsObjectNotFound() {
+		if isNotFoundError(err) {
 			log.Printf("[WARN] Removing GCP cloud account %s from state because it no longer exists in Dome9", d.Id())
 			d.SetId("")
 			return nil
@@ -122,10 +126,16 @@ func resourceCloudAccountGCPRead(d *schema.ResourceData, meta interface{}) error
 	_ = d.Set("domain_name", resp.GSuite.DomainName)
 	_ = d.Set("vendor", resp.Vendor)
 
+	setMissingDefaults(d, map[string]interface{}{"deletion_protection": false})
+
 	return nil
 }
 
 func resourceCloudAccountGCPDelete(d *schema.ResourceData, meta interface{}) error {
+	if err := checkDeletionProtection(d, resourcetype.CloudAccountGCP); err != nil {
+		return err
+	}
+
 	d9Client := meta.(*Client)
 	log.Printf("[INFO] Deleting GCP Cloud Account ID: %v\n", d.Id())
 
@@ -4,8 +4,9 @@ import (
 	"github.com/dome9/dome9-sdk-go/services/cloudaccounts/k8s"
 	"log"
 
-	"github.com/dome9/dome9-sdk-go/dome9/client"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/terraform-providers/terraform-provider-dome9/dome9/common/resourcetype"
 )
 
 func resourceCloudAccountKubernetes() *schema.Resource {
@@ -103,6 +104,7 @@ func resourceCloudAccountKubernetes() *schema.Resource {
 					},
 				},
 			},
+			"deletion_protection": deletionProtectionSchema(),
 		},
 	}
 }
@@ -134,7 +136,7 @@ func resourceCloudAccountKubernetesRead(d *schema.ResourceData, meta interface{}
 	resp, _, err := d9Client.cloudaccountKubernetes.Get(d.Id())
 
 	if err != nil {
-		if err.(*client.ErrorResponse).IsObjectNotFound() { // 404 response code
+		if isNotFoundError(err) { // 404 response code
 			log.Printf("[WARN] Removing Kubernetes cloud account %s from state because it no longer exists in Dome9", d.Id())
 			d.SetId("")
 			return nil
@@ -157,10 +159,16 @@ func resourceCloudAccountKubernetesRead(d *schema.ResourceData, meta interface{}
 	_ = d.Set("image_assurance", expandImageAssuranceConfig(resp))
 	_ = d.Set("threat_intelligence", expandThreatIntelligenceConfig(resp))
 
+	setMissingDefaults(d, map[string]interface{}{"deletion_protection": false})
+
 	return nil
 }
 
 func resourceCloudAccountKubernetesDelete(d *schema.ResourceData, meta interface{}) error {
+	if err := checkDeletionProtection(d, resourcetype.CloudAccountKubernetes); err != nil {
+		return err
+	}
+
 	d9Client := meta.(*Client)
 	log.Printf("[INFO] Deleting Kubernetes Cloud Account ID: %v\n", d.Id())
 
@@ -108,6 +108,49 @@ func TestAccResourceCloudAccountKubernetesBasic(t *testing.T) {
 	})
 }
 
+func TestResourceCloudAccountKubernetesLifecycle(t *testing.T) {
+	api := newFakeDome9API(t)
+	resourceTypeAndName, _, generatedName := method.GenerateRandomSourcesTypeAndName(resourcetype.CloudAccountKubernetes)
+	resourceName := variable.CloudAccountKubernetesOriginalAccountName + "_" + generatedName
+	updatedFeaturesConfig := getCloudAccountKubernetesResourceHCLWithfeatures(generatedName, variable.CloudAccountKubernetesOriginalAccountName,
+		variable.CloudAccountKubernetesRuntimeProtectionUpdateEnabled, variable.CloudAccountKubernetesAdmissionControlUpdateEnabled,
+		variable.CloudAccountKubernetesImageAssuranceUpdateEnabled, variable.CloudAccountKubernetesThreatIntelligenceUpdateEnabled)
+	renameConfig := getBasicCloudAccountKubernetesResourceHCL(generatedName, variable.CloudAccountKubernetesUpdatedAccountName)
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    api.providers(),
+		CheckDestroy: api.checkDestroyed("kubernetes/account"),
+		Steps: []resource.TestStep{
+			{
+				Config: api.config(testAccCheckCloudAccountKubernetesCreateWithFeatures(resourceTypeAndName, generatedName, variable.CloudAccountKubernetesOriginalAccountName)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceTypeAndName, "name", resourceName),
+					resource.TestCheckResourceAttr(resourceTypeAndName, "vendor", variable.CloudAccountKubernetesVendor),
+					resource.TestCheckResourceAttr(resourceTypeAndName, "runtime_protection.0.enabled", strconv.FormatBool(variable.CloudAccountKubernetesRuntimeProtectionEnabled)),
+					resource.TestCheckResourceAttr(resourceTypeAndName, "admission_control.0.enabled", strconv.FormatBool(variable.CloudAccountKubernetesAdmissionControlEnabled)),
+					resource.TestCheckResourceAttr(resourceTypeAndName, "image_assurance.0.enabled", strconv.FormatBool(variable.CloudAccountKubernetesImageAssuranceEnabled)),
+					resource.TestCheckResourceAttr(resourceTypeAndName, "threat_intelligence.0.enabled", strconv.FormatBool(variable.CloudAccountKubernetesThreatIntelligenceEnabled)),
+					resource.TestCheckResourceAttrPair("data."+resourceTypeAndName, "name", resourceTypeAndName, "name"),
+				),
+			},
+			{
+				Config: api.config(updatedFeaturesConfig),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceTypeAndName, "runtime_protection.0.enabled", strconv.FormatBool(variable.CloudAccountKubernetesRuntimeProtectionUpdateEnabled)),
+					resource.TestCheckResourceAttr(resourceTypeAndName, "admission_control.0.enabled", strconv.FormatBool(variable.CloudAccountKubernetesAdmissionControlUpdateEnabled)),
+					resource.TestCheckResourceAttr(resourceTypeAndName, "image_assurance.0.enabled", strconv.FormatBool(variable.CloudAccountKubernetesImageAssuranceUpdateEnabled)),
+					resource.TestCheckResourceAttr(resourceTypeAndName, "threat_intelligence.0.enabled", strconv.FormatBool(variable.CloudAccountKubernetesThreatIntelligenceUpdateEnabled)),
+				),
+			},
+			{
+				Config: api.config(renameConfig),
+				Check:  resource.TestCheckResourceAttr(resourceTypeAndName, "name", variable.CloudAccountKubernetesUpdatedAccountName),
+			},
+			api.importStep(renameConfig, resourceTypeAndName),
+		},
+	})
+}
+
 func testAccCheckCloudAccountKubernetesExists(resource string, cloudAccount *k8s.CloudAccountResponse) resource.TestCheckFunc {
 	return func(state *terraform.State) error {
 		rs, ok := state.RootModule().Resources[resource]
@@ -1,10 +1,11 @@
 package dome9
 
 import (
-	"github.com/dome9/dome9-sdk-go/dome9/client"
 	"github.com/dome9/dome9-sdk-go/services/cloudaccounts/oci"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"log"
+
+	"github.com/terraform-providers/terraform-provider-dome9/dome9/common/resourcetype"
 )
 
 func resourceCloudAccountOCI() *schema.Resource {
@@ -13,9 +14,11 @@ func resourceCloudAccountOCI() *schema.Resource {
 		Read:   resourceCloudAccountOciRead,
 		Update: resourceCloudAccountOciUpdate,
 		Delete: resourceCloudAccountOciDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Importer: ociCloudAccountLookup.importer(),
+		CustomizeDiff: customizeCloudAccountDiff(
+			cloudAccountCheck{key: "tenancy_id", pattern: ociTenancyPattern, expected: "the OCID of the tenancy, ocid1.tenancy..."},
+			cloudAccountCheck{key: "user_ocid", pattern: ociUserPattern, expected: "the OCID of the Dome9 user, ocid1.user..."},
+		),
 
 		Schema: map[string]*schema.Schema{
 			"tenancy_id": {
@@ -75,6 +78,7 @@ func resourceCloudAccountOCI() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"deletion_protection": deletionProtectionSchema(),
 		},
 	}
 }
@@ -100,7 +104,7 @@ func resourceCloudAccountOciRead(d *schema.ResourceData, meta interface{}) error
 	resp, _, err := d9Client.cloudaccountOci.Get(d.Id())
 
 	if err != nil {
-		if err.(*client.ErrorResponse).IsObjectNotFound() {
+		if isNotFoundError(err) {
 			log.Printf("[WARN] Removing Oci cloud account %s from state because it no longer exists in Dome9", d.Id())
 			d.SetId("")
 			return nil
@@ -123,10 +127,16 @@ func resourceCloudAccountOciRead(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 
+	setMissingDefaults(d, map[string]interface{}{"deletion_protection": false})
+
 	return nil
 }
 
 func resourceCloudAccountOciDelete(d *schema.ResourceData, meta interface{}) error {
+	if err := checkDeletionProtection(d, resourcetype.CloudAccountOCI); err != nil {
+		return err
+	}
+
 	d9Client := meta.(*Client)
 	log.Printf("[INFO] Deleting Oci Cloud Account ID: %v\n", d.Id())
 	if _, err := d9Client.cloudaccountOci.Delete(d.Id()); err != nil {
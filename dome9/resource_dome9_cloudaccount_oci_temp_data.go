@@ -4,6 +4,8 @@ import (
 	"github.com/dome9/dome9-sdk-go/services/cloudaccounts/oci"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"log"
+
+	"github.com/terraform-providers/terraform-provider-dome9/dome9/common/resourcetype"
 )
 
 func resourceCloudAccountOciTempData() *schema.Resource {
@@ -73,6 +75,7 @@ func resourceCloudAccountOciTempData() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"deletion_protection": deletionProtectionSchema(),
 		},
 	}
 }
@@ -107,10 +110,15 @@ func resourceCloudAccountOciTempDataCreate(d *schema.ResourceData, meta interfac
 }
 
 func resourceCloudAccountOciTempDataRead(d *schema.ResourceData, meta interface{}) error {
+	setMissingDefaults(d, map[string]interface{}{"deletion_protection": false})
 	return nil
 }
 
 func resourceCloudAccountOciTempDataDelete(d *schema.ResourceData, meta interface{}) error {
+	if err := checkDeletionProtection(d, resourcetype.CloudAccountOCITempData); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -40,6 +40,40 @@ func TestAccResourceCloudAccountOciBasic(t *testing.T) {
 	})
 }
 
+func TestResourceCloudAccountOciLifecycle(t *testing.T) {
+	api := newFakeDome9API(t)
+	t.Setenv(environmentvariable.CloudAccountOciEnvVarTenancyId, "ocid1.tenancy.oc1..fake")
+	t.Setenv(environmentvariable.CloudAccountOciEnvVarHomeRegion, "us-ashburn-1")
+	t.Setenv(environmentvariable.CloudAccountOciEnvVarUserOcid, "ocid1.user.oc1..fake")
+	resourceTypeAndName, _, generatedName := method.GenerateRandomSourcesTypeAndName(resourcetype.CloudAccountOCI)
+	organizationUnitTypeAndName, _, organizationUnitGeneratedName := method.GenerateRandomSourcesTypeAndName(resourcetype.OrganizationalUnit)
+	createConfig := getCloudAccountOciOnboardingHCL(generatedName, "")
+	updateConfig := getOrganizationalUnitResourceHCL(organizationUnitGeneratedName, variable.OrganizationalUnitName) +
+		getCloudAccountOciOnboardingHCL(generatedName, organizationUnitTypeAndName)
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    api.providers(),
+		CheckDestroy: api.checkDestroyed("oci-cloud-account"),
+		Steps: []resource.TestStep{
+			{
+				Config: api.config(createConfig),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceTypeAndName, "name", variable.CloudAccountOciCreationResourceName),
+					resource.TestCheckResourceAttr(resourceTypeAndName, "home_region", "us-ashburn-1"),
+					resource.TestCheckResourceAttr(resourceTypeAndName, "vendor", variable.CloudAccountOciVendor),
+					resource.TestCheckResourceAttr(resourceTypeAndName, "credentials.user", "ocid1.user.oc1..fake"),
+				),
+			},
+			{
+				Config: api.config(updateConfig),
+				Check:  resource.TestCheckResourceAttrPair(resourceTypeAndName, "organizational_unit_id", organizationUnitTypeAndName, "id"),
+			},
+			// the API does not return the user OCID of the onboarding request
+			api.importStep(updateConfig, resourceTypeAndName, "user_ocid"),
+		},
+	})
+}
+
 func testAccCheckCloudAccountOciDestroy(s *terraform.State) error {
 	apiClient := testAccProvider.Meta().(*Client)
 
@@ -126,3 +160,29 @@ resource "%s" "%s" {
 		os.Getenv(environmentvariable.CloudAccountOciEnvVarUserOcid),
 	)
 }
+
+// getCloudAccountOciOnboardingHCL saves the temp data of the tenancy before onboarding it, in the organizational unit
+// of organizationUnitTypeAndName when set
+func getCloudAccountOciOnboardingHCL(generatedName, organizationUnitTypeAndName string) string {
+	organizationalUnit := ""
+	if organizationUnitTypeAndName != "" {
+		organizationalUnit = fmt.Sprintf("organizational_unit_id = \"${%s.id}\"", organizationUnitTypeAndName)
+	}
+
+	return fmt.Sprintf(`
+%s
+resource "%s" "%s" {
+	tenancy_id = "${%s.%s.tenancy_id}"
+	user_ocid  = "%s"
+	%s
+}
+`,
+		getCloudAccountOciTempDataResourceHCL(generatedName, variable.CloudAccountOciCreationResourceName),
+		resourcetype.CloudAccountOCI,
+		generatedName,
+		resourcetype.CloudAccountOCITempData,
+		generatedName,
+		os.Getenv(environmentvariable.CloudAccountOciEnvVarUserOcid),
+		organizationalUnit,
+	)
+}
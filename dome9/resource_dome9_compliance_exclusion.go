@@ -0,0 +1,254 @@
+package dome9
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/dome9/dome9-sdk-go/services/assessment"
+	"github.com/dome9/dome9-sdk-go/services/compliance/compliance_exclusion"
+)
+
+func resourceComplianceExclusion() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceComplianceExclusionCreate,
+		Read:   resourceComplianceExclusionRead,
+		Update: resourceComplianceExclusionUpdate,
+		Delete: resourceComplianceExclusionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: complianceScopeSchema(),
+	}
+}
+
+// complianceScopeSchema returns the arguments shared by the exclusions and the remediations, selecting the
+// findings of a ruleset they apply to
+func complianceScopeSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"ruleset_id": {
+			Type:     schema.TypeInt,
+			Required: true,
+		},
+		"platform": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+		"comment": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"rules": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"logic_hash": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"name": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"id": {
+						Type:     schema.TypeInt,
+						Optional: true,
+						Computed: true,
+					},
+				},
+			},
+		},
+		"logic_expressions": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validateGSLLogicExpression,
+			},
+		},
+		"cloud_account_ids": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"organizational_unit_ids": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"date_range": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"from": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.IsRFC3339Time,
+					},
+					"to": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.IsRFC3339Time,
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceComplianceExclusionCreate(d *schema.ResourceData, meta interface{}) error {
+	d9Client := meta.(*Client)
+	req, err := expandComplianceExclusion(d)
+	if err != nil {
+		return err
+	}
+	log.Printf("[INFO] Creating compliance exclusion with request\n%+v\n", req)
+
+	resp, _, err := d9Client.complianceExclusion.Create(req)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(string(resp.ID))
+	return resourceComplianceExclusionRead(d, meta)
+}
+
+func resourceComplianceExclusionRead(d *schema.ResourceData, meta interface{}) error {
+	d9Client := meta.(*Client)
+	// the API has no call returning a single exclusion, Get lists every exclusion of the account on each Read
+	resp, _, err := d9Client.complianceExclusion.Get(d.Id())
+	if err != nil {
+		if isNotFoundError(err) {
+			log.Printf("[WARN] Removing compliance exclusion %s from state because it no longer exists in Dome9", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		return err
+	}
+
+	log.Printf("[INFO] Getting compliance exclusion:\n%+v\n", resp)
+	_ = d.Set("ruleset_id", resp.RulesetId)
+	_ = d.Set("platform", resp.Platform)
+	_ = d.Set("comment", resp.Comment)
+	_ = d.Set("logic_expressions", resp.LogicExpressions)
+	_ = d.Set("cloud_account_ids", resp.CloudAccountIds)
+	_ = d.Set("organizational_unit_ids", resp.OrganizationalUnitIds)
+	if err := d.Set("rules", flattenComplianceRules(resp.Rules)); err != nil {
+		return err
+	}
+	if err := d.Set("date_range", flattenComplianceDateRange(resp.DateRange)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceComplianceExclusionUpdate(d *schema.ResourceData, meta interface{}) error {
+	d9Client := meta.(*Client)
+	req, err := expandComplianceExclusion(d)
+	if err != nil {
+		return err
+	}
+	log.Printf("[INFO] Updating compliance exclusion %s with request\n%+v\n", d.Id(), req)
+
+	if _, _, err := d9Client.complianceExclusion.Update(d.Id(), req); err != nil {
+		return err
+	}
+
+	return resourceComplianceExclusionRead(d, meta)
+}
+
+func resourceComplianceExclusionDelete(d *schema.ResourceData, meta interface{}) error {
+	d9Client := meta.(*Client)
+	log.Printf("[INFO] Deleting compliance exclusion with id %v\n", d.Id())
+
+	if _, err := d9Client.complianceExclusion.Delete(d.Id()); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func expandComplianceExclusion(d *schema.ResourceData) (*compliance_exclusion.ComplianceExclusion, error) {
+	dateRange, err := expandComplianceDateRange(d)
+	if err != nil {
+		return nil, err
+	}
+
+	return &compliance_exclusion.ComplianceExclusion{
+		Platform:              d.Get("platform").(string),
+		RulesetId:             d.Get("ruleset_id").(int),
+		Comment:               d.Get("comment").(string),
+		Rules:                 expandComplianceRules(d),
+		LogicExpressions:      expandStringList(d.Get("logic_expressions").([]interface{})),
+		CloudAccountIds:       expandStringList(d.Get("cloud_account_ids").(*schema.Set).List()),
+		OrganizationalUnitIds: expandStringList(d.Get("organizational_unit_ids").(*schema.Set).List()),
+		DateRange:             dateRange,
+	}, nil
+}
+
+// expandComplianceRules returns the rules block shared by the exclusions and the remediations
+func expandComplianceRules(d *schema.ResourceData) []assessment.ExclusionOrRemediationRule {
+	items := d.Get("rules").([]interface{})
+	rules := make([]assessment.ExclusionOrRemediationRule, len(items))
+	for i, item := range items {
+		rule := item.(map[string]interface{})
+		rules[i] = assessment.ExclusionOrRemediationRule{
+			LogicHash: rule["logic_hash"].(string),
+			Name:      rule["name"].(string),
+			ID:        rule["id"].(int),
+		}
+	}
+
+	return rules
+}
+
+func flattenComplianceRules(responseRules []assessment.ExclusionOrRemediationRule) []interface{} {
+	rules := make([]interface{}, len(responseRules))
+	for i, val := range responseRules {
+		rules[i] = map[string]interface{}{
+			"logic_hash": val.LogicHash,
+			"name":       val.Name,
+			"id":         val.ID,
+		}
+	}
+
+	return rules
+}
+
+// expandComplianceDateRange returns the date_range block, nil when the exclusion or remediation never expires
+func expandComplianceDateRange(d *schema.ResourceData) (*assessment.Date, error) {
+	items := d.Get("date_range").([]interface{})
+	if len(items) == 0 || items[0] == nil {
+		return nil, nil
+	}
+
+	dateRange := items[0].(map[string]interface{})
+	from, _ := time.Parse(time.RFC3339, dateRange["from"].(string))
+	to, _ := time.Parse(time.RFC3339, dateRange["to"].(string))
+	if !to.After(from) {
+		return nil, fmt.Errorf("date_range.0.to (%s) must be after date_range.0.from (%s)", dateRange["to"], dateRange["from"])
+	}
+
+	return &assessment.Date{From: dateRange["from"].(string), To: dateRange["to"].(string)}, nil
+}
+
+func flattenComplianceDateRange(dateRange *assessment.Date) []interface{} {
+	if dateRange == nil || (dateRange.From == "" && dateRange.To == "") {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"from": dateRange.From,
+		"to":   dateRange.To,
+	}}
+}
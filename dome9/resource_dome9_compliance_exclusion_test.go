@@ -0,0 +1,171 @@
+package dome9
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/dome9/dome9-sdk-go/services/compliance/compliance_exclusion"
+
+	"github.com/terraform-providers/terraform-provider-dome9/dome9/common/resourcetype"
+	"github.com/terraform-providers/terraform-provider-dome9/dome9/common/testing/method"
+	"github.com/terraform-providers/terraform-provider-dome9/dome9/common/testing/variable"
+)
+
+func TestAccResourceComplianceExclusionBasic(t *testing.T) {
+	var exclusion compliance_exclusion.ComplianceExclusion
+	resourceTypeAndName, _, generatedName := method.GenerateRandomSourcesTypeAndName(resourcetype.ComplianceExclusion)
+	_, _, ruleSetGeneratedName := method.GenerateRandomSourcesTypeAndName(resourcetype.RuleSet)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComplianceExclusionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: getComplianceExclusionResourceHCL(generatedName, ruleSetGeneratedName, variable.ComplianceExclusionComment),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComplianceExclusionExists(resourceTypeAndName, &exclusion),
+					resource.TestCheckResourceAttr(resourceTypeAndName, "comment", variable.ComplianceExclusionComment),
+					resource.TestCheckResourceAttr(resourceTypeAndName, "rules.#", "1"),
+					resource.TestCheckResourceAttr(resourceTypeAndName, "date_range.0.to", variable.ComplianceExclusionDateTo),
+				),
+			},
+
+			// Update test
+			{
+				Config: getComplianceExclusionResourceHCL(generatedName, ruleSetGeneratedName, variable.ComplianceExclusionCommentUpdate),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComplianceExclusionExists(resourceTypeAndName, &exclusion),
+					resource.TestCheckResourceAttr(resourceTypeAndName, "comment", variable.ComplianceExclusionCommentUpdate),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceComplianceExclusionLifecycle(t *testing.T) {
+	api := newFakeDome9API(t)
+	resourceTypeAndName, _, generatedName := method.GenerateRandomSourcesTypeAndName(resourcetype.ComplianceExclusion)
+	_, _, ruleSetGeneratedName := method.GenerateRandomSourcesTypeAndName(resourcetype.RuleSet)
+	updateConfig := getComplianceExclusionResourceHCL(generatedName, ruleSetGeneratedName, variable.ComplianceExclusionCommentUpdate)
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    api.providers(),
+		CheckDestroy: api.checkDestroyed("Compliance/Exclusion"),
+		Steps: []resource.TestStep{
+			{
+				Config: api.config(testAccCheckComplianceExclusionsConfigure(generatedName, ruleSetGeneratedName, variable.ComplianceExclusionComment)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceTypeAndName, "comment", variable.ComplianceExclusionComment),
+					resource.TestCheckResourceAttrPair(resourceTypeAndName, "ruleset_id", resourcetype.RuleSet+"."+ruleSetGeneratedName, "id"),
+					resource.TestCheckResourceAttr(resourceTypeAndName, "rules.0.logic_hash", "logic-hash"),
+					resource.TestCheckResourceAttr(resourceTypeAndName, "cloud_account_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceTypeAndName, "date_range.0.from", variable.ComplianceExclusionDateFrom),
+					resource.TestCheckResourceAttr("data."+resourcetype.ComplianceExclusions+"."+generatedName, "ids.#", "1"),
+					resource.TestCheckResourceAttrPair("data."+resourcetype.ComplianceExclusions+"."+generatedName, "ids.0", resourceTypeAndName, "id"),
+					resource.TestCheckResourceAttr("data."+resourcetype.ComplianceExclusions+"."+generatedName, "exclusions.0.comment", variable.ComplianceExclusionComment),
+				),
+			},
+			{
+				Config: api.config(updateConfig),
+				Check:  resource.TestCheckResourceAttr(resourceTypeAndName, "comment", variable.ComplianceExclusionCommentUpdate),
+			},
+			api.importStep(updateConfig, resourceTypeAndName),
+		},
+	})
+}
+
+func testAccCheckComplianceExclusionDestroy(s *terraform.State) error {
+	apiClient := testAccProvider.Meta().(*Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != resourcetype.ComplianceExclusion {
+			continue
+		}
+
+		exclusion, _, err := apiClient.complianceExclusion.Get(rs.Primary.ID)
+		if err == nil || exclusion != nil {
+			return fmt.Errorf("compliance exclusion with id %s exists and wasn't destroyed", rs.Primary.ID)
+		}
+		if !isNotFoundError(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckComplianceExclusionExists(resource string, exclusion *compliance_exclusion.ComplianceExclusion) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resource]
+		if !ok {
+			return fmt.Errorf("didn't find resource: %s", resource)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no record ID is set")
+		}
+
+		apiClient := testAccProvider.Meta().(*Client)
+		receivedExclusion, _, err := apiClient.complianceExclusion.Get(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("failed fetching resource %s. Recevied error: %s", resource, err)
+		}
+		*exclusion = *receivedExclusion
+
+		return nil
+	}
+}
+
+func testAccCheckComplianceExclusionsConfigure(generatedName, ruleSetGeneratedName, comment string) string {
+	return fmt.Sprintf(`
+%s
+data "%s" "%s" {
+  ruleset_id = "${%s.%s.ruleset_id}"
+}
+`,
+		// resource variables
+		getComplianceExclusionResourceHCL(generatedName, ruleSetGeneratedName, comment),
+
+		// data source variables
+		resourcetype.ComplianceExclusions,
+		generatedName,
+		resourcetype.ComplianceExclusion,
+		generatedName,
+	)
+}
+
+func getComplianceExclusionResourceHCL(generatedName, ruleSetGeneratedName, comment string) string {
+	return fmt.Sprintf(`
+%s
+resource "%s" "%s" {
+  ruleset_id        = "${%s.%s.id}"
+  comment           = "%s"
+  cloud_account_ids = ["00000000-0000-0000-0000-000000000001"]
+
+  rules {
+    logic_hash = "logic-hash"
+    name       = "acceptance test rule"
+  }
+
+  date_range {
+    from = "%s"
+    to   = "%s"
+  }
+}
+`,
+		// ruleset variables
+		getRuleSetResourceHCL(ruleSetGeneratedName, variable.RuleSetDescription),
+
+		// resource variables
+		resourcetype.ComplianceExclusion,
+		generatedName,
+		resourcetype.RuleSet,
+		ruleSetGeneratedName,
+		comment,
+		variable.ComplianceExclusionDateFrom,
+		variable.ComplianceExclusionDateTo,
+	)
+}
@@ -0,0 +1,129 @@
+package dome9
+
+import (
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/dome9/dome9-sdk-go/services/compliance/compliance_remediation"
+)
+
+func resourceComplianceRemediation() *schema.Resource {
+	remediationSchema := complianceScopeSchema()
+	remediationSchema["cloud_bots"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MinItems: 1,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validation.NoZeroValues,
+		},
+	}
+
+	return &schema.Resource{
+		Create: resourceComplianceRemediationCreate,
+		Read:   resourceComplianceRemediationRead,
+		Update: resourceComplianceRemediationUpdate,
+		Delete: resourceComplianceRemediationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: remediationSchema,
+	}
+}
+
+func resourceComplianceRemediationCreate(d *schema.ResourceData, meta interface{}) error {
+	d9Client := meta.(*Client)
+	req, err := expandComplianceRemediation(d)
+	if err != nil {
+		return err
+	}
+	log.Printf("[INFO] Creating compliance remediation with request\n%+v\n", req)
+
+	resp, _, err := d9Client.complianceRemediation.Create(req)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(string(resp.ID))
+	return resourceComplianceRemediationRead(d, meta)
+}
+
+func resourceComplianceRemediationRead(d *schema.ResourceData, meta interface{}) error {
+	d9Client := meta.(*Client)
+	// the API has no call returning a single remediation, Get lists every remediation of the account on each Read
+	resp, _, err := d9Client.complianceRemediation.Get(d.Id())
+	if err != nil {
+		if isNotFoundError(err) {
+			log.Printf("[WARN] Removing compliance remediation %s from state because it no longer exists in Dome9", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		return err
+	}
+
+	log.Printf("[INFO] Getting compliance remediation:\n%+v\n", resp)
+	_ = d.Set("ruleset_id", resp.RulesetId)
+	_ = d.Set("platform", resp.Platform)
+	_ = d.Set("comment", resp.Comment)
+	_ = d.Set("cloud_bots", resp.CloudBots)
+	_ = d.Set("logic_expressions", resp.LogicExpressions)
+	_ = d.Set("cloud_account_ids", resp.CloudAccountIds)
+	_ = d.Set("organizational_unit_ids", resp.OrganizationalUnitIds)
+	if err := d.Set("rules", flattenComplianceRules(resp.Rules)); err != nil {
+		return err
+	}
+	if err := d.Set("date_range", flattenComplianceDateRange(resp.DateRange)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceComplianceRemediationUpdate(d *schema.ResourceData, meta interface{}) error {
+	d9Client := meta.(*Client)
+	req, err := expandComplianceRemediation(d)
+	if err != nil {
+		return err
+	}
+	log.Printf("[INFO] Updating compliance remediation %s with request\n%+v\n", d.Id(), req)
+
+	if _, _, err := d9Client.complianceRemediation.Update(d.Id(), req); err != nil {
+		return err
+	}
+
+	return resourceComplianceRemediationRead(d, meta)
+}
+
+func resourceComplianceRemediationDelete(d *schema.ResourceData, meta interface{}) error {
+	d9Client := meta.(*Client)
+	log.Printf("[INFO] Deleting compliance remediation with id %v\n", d.Id())
+
+	if _, err := d9Client.complianceRemediation.Delete(d.Id()); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func expandComplianceRemediation(d *schema.ResourceData) (*compliance_remediation.ComplianceRemediation, error) {
+	dateRange, err := expandComplianceDateRange(d)
+	if err != nil {
+		return nil, err
+	}
+
+	return &compliance_remediation.ComplianceRemediation{
+		Platform:              d.Get("platform").(string),
+		RulesetId:             d.Get("ruleset_id").(int),
+		Comment:               d.Get("comment").(string),
+		CloudBots:             expandStringList(d.Get("cloud_bots").([]interface{})),
+		Rules:                 expandComplianceRules(d),
+		LogicExpressions:      expandStringList(d.Get("logic_expressions").([]interface{})),
+		CloudAccountIds:       expandStringList(d.Get("cloud_account_ids").(*schema.Set).List()),
+		OrganizationalUnitIds: expandStringList(d.Get("organizational_unit_ids").(*schema.Set).List()),
+		DateRange:             dateRange,
+	}, nil
+}
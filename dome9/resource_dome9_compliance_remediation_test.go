@@ -0,0 +1,149 @@
+package dome9
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/dome9/dome9-sdk-go/services/compliance/compliance_remediation"
+
+	"github.com/terraform-providers/terraform-provider-dome9/dome9/common/resourcetype"
+	"github.com/terraform-providers/terraform-provider-dome9/dome9/common/testing/method"
+	"github.com/terraform-providers/terraform-provider-dome9/dome9/common/testing/variable"
+)
+
+func TestAccResourceComplianceRemediationBasic(t *testing.T) {
+	var remediation compliance_remediation.ComplianceRemediation
+	resourceTypeAndName, _, generatedName := method.GenerateRandomSourcesTypeAndName(resourcetype.ComplianceRemediation)
+	_, _, ruleSetGeneratedName := method.GenerateRandomSourcesTypeAndName(resourcetype.RuleSet)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComplianceRemediationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: getComplianceRemediationResourceHCL(generatedName, ruleSetGeneratedName, variable.ComplianceRemediationComment, variable.ComplianceRemediationCloudBot),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComplianceRemediationExists(resourceTypeAndName, &remediation),
+					resource.TestCheckResourceAttr(resourceTypeAndName, "comment", variable.ComplianceRemediationComment),
+					resource.TestCheckResourceAttr(resourceTypeAndName, "cloud_bots.#", "1"),
+					resource.TestCheckResourceAttr(resourceTypeAndName, "cloud_bots.0", variable.ComplianceRemediationCloudBot),
+				),
+			},
+
+			// Update test
+			{
+				Config: getComplianceRemediationResourceHCL(generatedName, ruleSetGeneratedName, variable.ComplianceRemediationCommentUpdate, variable.ComplianceRemediationCloudBot, variable.ComplianceRemediationCloudBotAdded),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComplianceRemediationExists(resourceTypeAndName, &remediation),
+					resource.TestCheckResourceAttr(resourceTypeAndName, "comment", variable.ComplianceRemediationCommentUpdate),
+					resource.TestCheckResourceAttr(resourceTypeAndName, "cloud_bots.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceComplianceRemediationLifecycle(t *testing.T) {
+	api := newFakeDome9API(t)
+	resourceTypeAndName, _, generatedName := method.GenerateRandomSourcesTypeAndName(resourcetype.ComplianceRemediation)
+	_, _, ruleSetGeneratedName := method.GenerateRandomSourcesTypeAndName(resourcetype.RuleSet)
+	updateConfig := getComplianceRemediationResourceHCL(generatedName, ruleSetGeneratedName, variable.ComplianceRemediationCommentUpdate, variable.ComplianceRemediationCloudBot, variable.ComplianceRemediationCloudBotAdded)
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    api.providers(),
+		CheckDestroy: api.checkDestroyed("Compliance/Remediation"),
+		Steps: []resource.TestStep{
+			{
+				Config: api.config(getComplianceRemediationResourceHCL(generatedName, ruleSetGeneratedName, variable.ComplianceRemediationComment, variable.ComplianceRemediationCloudBot)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceTypeAndName, "comment", variable.ComplianceRemediationComment),
+					resource.TestCheckResourceAttrPair(resourceTypeAndName, "ruleset_id", resourcetype.RuleSet+"."+ruleSetGeneratedName, "id"),
+					resource.TestCheckResourceAttr(resourceTypeAndName, "cloud_bots.0", variable.ComplianceRemediationCloudBot),
+					resource.TestCheckResourceAttr(resourceTypeAndName, "rules.0.logic_hash", "logic-hash"),
+				),
+			},
+			{
+				Config: api.config(updateConfig),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceTypeAndName, "comment", variable.ComplianceRemediationCommentUpdate),
+					resource.TestCheckResourceAttr(resourceTypeAndName, "cloud_bots.1", variable.ComplianceRemediationCloudBotAdded),
+				),
+			},
+			api.importStep(updateConfig, resourceTypeAndName),
+		},
+	})
+}
+
+func testAccCheckComplianceRemediationDestroy(s *terraform.State) error {
+	apiClient := testAccProvider.Meta().(*Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != resourcetype.ComplianceRemediation {
+			continue
+		}
+
+		remediation, _, err := apiClient.complianceRemediation.Get(rs.Primary.ID)
+		if err == nil || remediation != nil {
+			return fmt.Errorf("compliance remediation with id %s exists and wasn't destroyed", rs.Primary.ID)
+		}
+		if !isNotFoundError(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckComplianceRemediationExists(resource string, remediation *compliance_remediation.ComplianceRemediation) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resource]
+		if !ok {
+			return fmt.Errorf("didn't find resource: %s", resource)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no record ID is set")
+		}
+
+		apiClient := testAccProvider.Meta().(*Client)
+		receivedRemediation, _, err := apiClient.complianceRemediation.Get(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("failed fetching resource %s. Recevied error: %s", resource, err)
+		}
+		*remediation = *receivedRemediation
+
+		return nil
+	}
+}
+
+func getComplianceRemediationResourceHCL(generatedName, ruleSetGeneratedName, comment string, cloudBots ...string) string {
+	return fmt.Sprintf(`
+%s
+resource "%s" "%s" {
+  ruleset_id        = "${%s.%s.id}"
+  comment           = "%s"
+  cloud_bots        = ["%s"]
+  logic_expressions = ["S3Bucket should have versioning.enabled=true"]
+
+  rules {
+    logic_hash = "logic-hash"
+    name       = "acceptance test rule"
+  }
+}
+`,
+		// ruleset variables
+		getRuleSetResourceHCL(ruleSetGeneratedName, variable.RuleSetDescription),
+
+		// resource variables
+		resourcetype.ComplianceRemediation,
+		generatedName,
+		resourcetype.RuleSet,
+		ruleSetGeneratedName,
+		comment,
+		strings.Join(cloudBots, `", "`),
+	)
+}
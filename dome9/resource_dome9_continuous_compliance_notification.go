@@ -9,7 +9,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 
-	"github.com/dome9/dome9-sdk-go/dome9/client"
 	"github.com/dome9/dome9-sdk-go/services/compliance/continuous_compliance_notification"
 	"github.com/terraform-providers/terraform-provider-dome9/dome9/common/providerconst"
 )
@@ -386,7 +385,7 @@ func resourceContinuousComplianceNotificationRead(d *schema.ResourceData, meta i
 	d9Client := meta.(*Client)
 	resp, _, err := d9Client.continuousComplianceNotification.Get(d.Id())
 	if err != nil {
-		if err.(*client.ErrorResponse).IsObjectNotFound() {
+		if isNotFoundError(err) {
 			log.Printf("[WARN] Removing continuous compliance notification %s from state because it no longer exists in Dome9", d.Id())
 			d.SetId("")
 			return nil
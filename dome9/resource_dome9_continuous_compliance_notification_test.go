@@ -51,6 +51,39 @@ func TestAccResourceContinuousComplianceNotificationBasic(t *testing.T) {
 	})
 }
 
+func TestResourceContinuousComplianceNotificationLifecycle(t *testing.T) {
+	api := newFakeDome9API(t)
+	notificationTypeAndName, _, notificationGeneratedName := method.GenerateRandomSourcesTypeAndName(resourcetype.ContinuousComplianceNotification)
+	// without the data source, which has the type and ID of the resource and would be compared on import
+	updateConfig := getContinuousComplianceNotificationResourceHCL(notificationGeneratedName, continuousComplianceNotificationUpdateConfig(notificationGeneratedName))
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    api.providers(),
+		CheckDestroy: api.checkDestroyed("Compliance/ContinuousComplianceNotification"),
+		Steps: []resource.TestStep{
+			{
+				Config: api.config(testAccCheckContinuousComplianceNotificationBasic(notificationTypeAndName, notificationGeneratedName, continuousComplianceNotificationConfig(notificationGeneratedName))),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(notificationTypeAndName, "name", variable.ContinuousComplianceNotificationName+"_"+notificationGeneratedName),
+					resource.TestCheckResourceAttr(notificationTypeAndName, "description", variable.ContinuousComplianceNotificationDescription),
+					resource.TestCheckResourceAttr(notificationTypeAndName, "alerts_console", strconv.FormatBool(variable.ContinuousComplianceNotificationAlertsConsole)),
+					resource.TestCheckResourceAttr(notificationTypeAndName, "change_detection.#", "1"),
+					resource.TestCheckResourceAttrPair("data."+notificationTypeAndName, "name", notificationTypeAndName, "name"),
+				),
+			},
+			{
+				Config: api.config(updateConfig),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(notificationTypeAndName, "name", variable.ContinuousComplianceNotificationUpdateName+"_"+notificationGeneratedName),
+					resource.TestCheckResourceAttr(notificationTypeAndName, "description", variable.ContinuousComplianceNotificationUpdateDescription),
+					resource.TestCheckResourceAttr(notificationTypeAndName, "alerts_console", strconv.FormatBool(variable.ContinuousComplianceNotificationUpdateAlertsConsole)),
+				),
+			},
+			api.importStep(updateConfig, notificationTypeAndName),
+		},
+	})
+}
+
 func testAccCheckContinuousComplianceNotificationExists(resource string, continuousComplianceNotification *continuous_compliance_notification.ContinuousComplianceNotificationResponse) resource.TestCheckFunc {
 	return func(state *terraform.State) error {
 		rs, ok := state.RootModule().Resources[resource]
@@ -3,7 +3,6 @@ package dome9
 import (
 	"log"
 
-	"github.com/dome9/dome9-sdk-go/dome9/client"
 	"github.com/dome9/dome9-sdk-go/services/compliance/continuous_compliance_policy"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
@@ -63,7 +62,7 @@ func resourceContinuousCompliancePolicyRead(d *schema.ResourceData, meta interfa
 	resp, _, err := d9Client.continuousCompliancePolicy.Get(d.Id())
 
 	if err != nil {
-		if err.(*client.ErrorResponse).IsObjectNotFound() {
+		if isNotFoundError(err) {
 			log.Printf("[WARN] Removing continuous compliance policy %s from state because it no longer exists in Dome9", d.Id())
 			d.SetId("")
 			return nil
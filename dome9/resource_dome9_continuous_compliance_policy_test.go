@@ -59,6 +59,44 @@ func TestAccResourceContinuousCompliancePolicyBasic(t *testing.T) {
 	})
 }
 
+func TestResourceContinuousCompliancePolicyLifecycle(t *testing.T) {
+	api := newFakeDome9API(t)
+	policyTypeAndName, _, policyGeneratedName := method.GenerateRandomSourcesTypeAndName(resourcetype.ContinuousCompliancePolicy)
+	awsTypeAndName, _, awsGeneratedName := method.GenerateRandomSourcesTypeAndName(resourcetype.CloudAccountAWS)
+	notificationTypeAndName, _, notificationGeneratedName := method.GenerateRandomSourcesTypeAndName(resourcetype.Notification)
+	notificationUpdateTypeAndName, _, notificationUpdateGeneratedName := method.GenerateRandomSourcesTypeAndName(resourcetype.Notification)
+	t.Setenv(environmentvariable.CloudAccountAWSEnvVarSecret, "secret")
+
+	awsHCL := getCloudAccountAWSResourceHCL(awsGeneratedName, variable.CloudAccountAWSOriginalAccountName, "arn:aws:iam::123456789012:role/dome9", "")
+	notificationHCL := getNotificationResourceHCL(notificationGeneratedName, notificationConfig(notificationGeneratedName))
+	notificationUpdateHCL := getNotificationResourceHCL(notificationUpdateGeneratedName, notificationUpdateConfig(notificationUpdateGeneratedName))
+	policyHCL := getContinuousCompliancePolicyResourceHCL(awsHCL, awsTypeAndName, notificationHCL, notificationTypeAndName, policyGeneratedName)
+	updateConfig := getUpdateContinuousCompliancePolicyResourceHCL(awsHCL, awsTypeAndName, notificationHCL, notificationUpdateTypeAndName, notificationUpdateHCL, policyGeneratedName)
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    api.providers(),
+		CheckDestroy: api.checkDestroyed("ContinuousCompliancePolicyV2"),
+		Steps: []resource.TestStep{
+			{
+				Config: api.config(testAccCheckContinuousCompliancePolicyBasic(policyHCL, policyGeneratedName, policyTypeAndName)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(policyTypeAndName, "target_id", awsTypeAndName, "id"),
+					resource.TestCheckResourceAttr(policyTypeAndName, "target_type", strings.Title(variable.CloudAccountAWSVendor)),
+					resource.TestCheckResourceAttrPair(policyTypeAndName, "notification_ids.0", notificationTypeAndName, "id"),
+				),
+			},
+			{
+				Config: api.config(updateConfig),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(policyTypeAndName, "notification_ids.#", "1"),
+					resource.TestCheckResourceAttrPair(policyTypeAndName, "notification_ids.0", notificationUpdateTypeAndName, "id"),
+				),
+			},
+			api.importStep(updateConfig, policyTypeAndName),
+		},
+	})
+}
+
 func testAccCheckContinuousCompliancePolicyExists(resource string, cloudAccount *continuous_compliance_policy.ContinuousCompliancePolicyResponse) resource.TestCheckFunc {
 	return func(state *terraform.State) error {
 		rs, ok := state.RootModule().Resources[resource]
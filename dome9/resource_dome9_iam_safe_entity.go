@@ -6,7 +6,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 
-	"github.com/dome9/dome9-sdk-go/dome9/client"
 	"github.com/dome9/dome9-sdk-go/services/cloudaccounts/aws"
 
 	"github.com/terraform-providers/terraform-provider-dome9/dome9/common/providerconst"
@@ -124,7 +123,7 @@ func resourceIAMSafeEntityRead(d *schema.ResourceData, meta interface{}) error {
 	resp, err = d9Client.cloudaccountAWS.GetProtectIAMSafeEntityStatusByName(cloudAccountID, entityName, entityType)
 
 	if err != nil {
-		if err.(*client.ErrorResponse).IsObjectNotFound() {
+		if isNotFoundError(err) {
 			log.Printf("[WARN] Removing protect IAM entity from state because aws cloud account %s no longer exists in Dome9", cloudAccountID)
 			d.SetId("")
 			return nil
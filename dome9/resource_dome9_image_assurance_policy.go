@@ -3,7 +3,6 @@ package dome9
 import (
 	"log"
 
-	"github.com/dome9/dome9-sdk-go/dome9/client"
 	"github.com/dome9/dome9-sdk-go/services/imageassurance/imageassurance_policy"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
@@ -83,7 +82,7 @@ func resourceImageAssurancePolicyRead(d *schema.ResourceData, meta interface{})
 	resp, _, err := d9Client.imageAssurancePolicy.Get(d.Id())
 
 	if err != nil {
-		if err.(*client.ErrorResponse).IsObjectNotFound() {
+		if isNotFoundError(err) {
 			log.Printf("[WARN] Removing ImageAssurance policy %s from state because it no longer exists in Dome9", d.Id())
 			d.SetId("")
 			return nil
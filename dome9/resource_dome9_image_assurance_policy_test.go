@@ -79,6 +79,49 @@ func TestAccResourceImagePolicyPolicyBasic(t *testing.T) {
 	})
 }
 
+func TestResourceImageAssurancePolicyLifecycle(t *testing.T) {
+	api := newFakeDome9API(t)
+	policyTypeAndName, _, policyGeneratedName := method.GenerateRandomSourcesTypeAndName(resourcetype.ImageAssurancePolicy)
+	notificationTypeAndName, _, notificationGeneratedName := method.GenerateRandomSourcesTypeAndName(resourcetype.Notification)
+	kubernetesAccountResourceTypeAndName, _, kubernetesAccountGeneratedName := method.GenerateRandomSourcesTypeAndName(resourcetype.CloudAccountKubernetes)
+
+	kubernetesAccountHCL := getCloudAccountKubernetesResourceHCLWithfeatures(kubernetesAccountGeneratedName, variable.ImageAssuranceKubernetesAccountName,
+		variable.CloudAccountKubernetesRuntimeProtectionEnabled,
+		variable.CloudAccountKubernetesAdmissionControlEnabled,
+		variable.CloudAccountKubernetesImageAssuranceEnabled,
+		variable.CloudAccountKubernetesThreatIntelligenceEnabled)
+	notificationHCL := getNotificationResourceHCL(notificationGeneratedName, notificationConfig(notificationGeneratedName))
+	policyHCL := getImageAssurancePolicyResourceHCL(kubernetesAccountHCL, kubernetesAccountResourceTypeAndName, notificationHCL,
+		notificationTypeAndName, policyGeneratedName, false)
+	updateConfig := getImageAssurancePolicyResourceHCL(kubernetesAccountHCL, kubernetesAccountResourceTypeAndName, notificationHCL,
+		notificationTypeAndName, policyGeneratedName, true)
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    api.providers(),
+		CheckDestroy: api.checkDestroyed("kubernetes/imageAssurance/policy"),
+		Steps: []resource.TestStep{
+			{
+				Config: api.config(testCheckImageAssurancePolicyBasic(policyHCL, policyGeneratedName, policyTypeAndName)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(policyTypeAndName, "target_id", kubernetesAccountResourceTypeAndName, "id"),
+					resource.TestCheckResourceAttrPair(policyTypeAndName, "notification_ids.0", notificationTypeAndName, "id"),
+					resource.TestCheckResourceAttr(policyTypeAndName, "admission_control_action", variable.ImageAssurancePolicyDetectAction),
+					resource.TestCheckResourceAttr(policyTypeAndName, "admission_control_unscanned_action", variable.ImageAssurancePolicyDetectAction),
+				),
+			},
+			{
+				Config: api.config(updateConfig),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(policyTypeAndName, "target_id", kubernetesAccountResourceTypeAndName, "id"),
+					resource.TestCheckResourceAttr(policyTypeAndName, "admission_control_action", variable.ImageAssurancePolicyPreventAction),
+					resource.TestCheckResourceAttr(policyTypeAndName, "admission_control_unscanned_action", variable.ImageAssurancePolicyPreventAction),
+				),
+			},
+			api.importStep(updateConfig, policyTypeAndName),
+		},
+	})
+}
+
 func testCheckImageAssurancePolicyExists(resource string, acPolicy *imageassurance_policy.ImageAssurancePolicyResponse) resource.TestCheckFunc {
 	return func(state *terraform.State) error {
 		rs, ok := state.RootModule().Resources[resource]
@@ -121,6 +121,12 @@ func resourceIntegrationRead(d *schema.ResourceData, meta interface{}) error {
 
 	resp, _, err := d9Client.integration.GetById(d.Id())
 	if err != nil {
+		if isNotFoundError(err) {
+			log.Printf("[WARN] Removing integration %s from state because it no longer exists in Dome9", d.Id())
+			d.SetId("")
+			return nil
+		}
+
 		return err
 	}
 
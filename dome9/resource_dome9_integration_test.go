@@ -173,3 +173,28 @@ configuration = jsonencode({
 		strconv.FormatBool(variable.IntegrationUpdateIgnoreCertificate),
 	)
 }
+
+func TestResourceIntegrationLifecycle(t *testing.T) {
+	api := newFakeDome9API(t)
+	integrationTypeAndName, _, integrationGeneratedName := method.GenerateRandomSourcesTypeAndName(resourcetype.Integration)
+	updateConfig := getIntegrationResourceHCL(integrationGeneratedName, integrationUpdateConfig(integrationGeneratedName))
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    api.providers(),
+		CheckDestroy: api.checkDestroyed("integration"),
+		Steps: []resource.TestStep{
+			{
+				Config: api.config(testAccCheckIntegrationBasic(integrationTypeAndName, integrationGeneratedName, integrationConfig(integrationGeneratedName))),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(integrationTypeAndName, "name", variable.IntegrationName+"_"+integrationGeneratedName),
+					resource.TestCheckResourceAttr(integrationTypeAndName, "type", variable.IntegrationType),
+				),
+			},
+			{
+				Config: api.config(updateConfig),
+				Check:  resource.TestCheckResourceAttr(integrationTypeAndName, "name", variable.IntegrationUpdateName+"_"+integrationGeneratedName),
+			},
+			api.importStep(updateConfig, integrationTypeAndName),
+		},
+	})
+}
@@ -6,7 +6,6 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 
-	"github.com/dome9/dome9-sdk-go/dome9/client"
 	"github.com/dome9/dome9-sdk-go/services/iplist"
 )
 
@@ -73,7 +72,7 @@ func resourceIpListRead(d *schema.ResourceData, meta interface{}) error {
 
 	ipList, _, err := d9Client.iplist.Get(id)
 	if err != nil {
-		if err.(*client.ErrorResponse).IsObjectNotFound() {
+		if isNotFoundError(err) {
 			log.Printf("[WARN] Removing ip list %s from state because it no longer exists in Dome9", d.Id())
 			d.SetId("")
 			return nil
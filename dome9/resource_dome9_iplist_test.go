@@ -106,6 +106,23 @@ func testAccCheckIPListExists(resource string, ipList *iplist.IpList) resource.T
 
 func testAccCheckIPListConfigure(resourceTypeAndName, generatedName, description string) string {
 	return fmt.Sprintf(`
+%s
+data "%s" "%s" {
+  id = "${%s.id}"
+}
+`,
+		// resource variables
+		getIPListResourceHCL(generatedName, description),
+
+		// data source variables
+		resourcetype.IPList,
+		generatedName,
+		resourceTypeAndName,
+	)
+}
+
+func getIPListResourceHCL(generatedName, description string) string {
+	return fmt.Sprintf(`
 resource "%s" "%s" {
   name        = "%s"
   description = "%s"
@@ -115,19 +132,37 @@ resource "%s" "%s" {
       comment = "Unused ip, just for testing"
     }
 }
-data "%s" "%s" {
-  id = "${%s.id}"
-}
 `,
 		// resource variables
 		resourcetype.IPList,
 		generatedName,
 		variable.IPListCreationResourceName,
 		description,
-
-		// data source variables
-		resourcetype.IPList,
-		generatedName,
-		resourceTypeAndName,
 	)
 }
+
+func TestResourceIPListLifecycle(t *testing.T) {
+	api := newFakeDome9API(t)
+	resourceTypeAndName, _, generatedName := method.GenerateRandomSourcesTypeAndName(resourcetype.IPList)
+	updateConfig := getIPListResourceHCL(generatedName, variable.IPListUpdateDescriptionResource)
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    api.providers(),
+		CheckDestroy: api.checkDestroyed("iplist"),
+		Steps: []resource.TestStep{
+			{
+				Config: api.config(testAccCheckIPListConfigure(resourceTypeAndName, generatedName, variable.IPListDescriptionResource)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceTypeAndName, "name", variable.IPListCreationResourceName),
+					resource.TestCheckResourceAttr(resourceTypeAndName, "description", variable.IPListDescriptionResource),
+					resource.TestCheckResourceAttr(resourceTypeAndName, "items.#", "1"),
+				),
+			},
+			{
+				Config: api.config(updateConfig),
+				Check:  resource.TestCheckResourceAttr(resourceTypeAndName, "description", variable.IPListUpdateDescriptionResource),
+			},
+			api.importStep(updateConfig, resourceTypeAndName),
+		},
+	})
+}
@@ -620,6 +620,12 @@ func resourceNotificationRead(d *schema.ResourceData, meta interface{}) error {
 
 	resp, _, err := d9Client.notifications.GetById(d.Id())
 	if err != nil {
+		if isNotFoundError(err) {
+			log.Printf("[WARN] Removing notification %s from state because it no longer exists in Dome9", d.Id())
+			d.SetId("")
+			return nil
+		}
+
 		return err
 	}
 
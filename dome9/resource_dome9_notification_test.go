@@ -156,3 +156,32 @@ send_on_each_occurrence = "%s"
 		strconv.FormatBool(variable.NotificationSendOnEachOccurrence),
 	)
 }
+
+func TestResourceNotificationLifecycle(t *testing.T) {
+	api := newFakeDome9API(t)
+	notificationTypeAndName, _, notificationGeneratedName := method.GenerateRandomSourcesTypeAndName(resourcetype.Notification)
+	updateConfig := getNotificationResourceHCL(notificationGeneratedName, notificationUpdateConfig(notificationGeneratedName))
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    api.providers(),
+		CheckDestroy: api.checkDestroyed("notification"),
+		Steps: []resource.TestStep{
+			{
+				Config: api.config(testAccCheckNotificationBasic(notificationTypeAndName, notificationGeneratedName, notificationConfig(notificationGeneratedName))),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(notificationTypeAndName, "name", variable.NotificationName+"_"+notificationGeneratedName),
+					resource.TestCheckResourceAttr(notificationTypeAndName, "description", variable.NotificationDescription),
+				),
+			},
+			{
+				Config: api.config(updateConfig),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(notificationTypeAndName, "name", variable.NotificationUpdateName+"_"+notificationGeneratedName),
+					resource.TestCheckResourceAttr(notificationTypeAndName, "description", variable.NotificationUpdateDescription),
+					resource.TestCheckResourceAttr(notificationTypeAndName, "alerts_console", strconv.FormatBool(variable.NotificationUpdateAlertsConsole)),
+				),
+			},
+			api.importStep(updateConfig, notificationTypeAndName),
+		},
+	})
+}
@@ -3,7 +3,6 @@ package dome9
 import (
 	"log"
 
-	"github.com/dome9/dome9-sdk-go/dome9/client"
 	"github.com/dome9/dome9-sdk-go/services/organizationalunits"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
@@ -147,7 +146,7 @@ func resourceOrganizationalUnitRead(d *schema.ResourceData, meta interface{}) er
 	resp, _, err := d9Client.organizationalUnit.Get(d.Id())
 
 	if err != nil {
-		if err.(*client.ErrorResponse).IsObjectNotFound() {
+		if isNotFoundError(err) {
 			log.Printf("[WARN] Removing Organizational Unit %s from state because it no longer exists in Dome9", d.Id())
 			d.SetId("")
 			return nil
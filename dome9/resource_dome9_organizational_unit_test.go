@@ -119,3 +119,28 @@ resource "%s" "%s" {
 		variable.ParentID,
 	)
 }
+
+func TestResourceOrganizationalUnitLifecycle(t *testing.T) {
+	api := newFakeDome9API(t)
+	resourceTypeAndName, _, generatedName := method.GenerateRandomSourcesTypeAndName(resourcetype.OrganizationalUnit)
+	updateConfig := getOrganizationalUnitResourceHCL(generatedName, variable.OrganizationalUnitNameUpdate)
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    api.providers(),
+		CheckDestroy: api.checkDestroyed("organizationalunit"),
+		Steps: []resource.TestStep{
+			{
+				Config: api.config(testAccCheckOrganizationalUnitConfigure(resourceTypeAndName, generatedName, variable.OrganizationalUnitName)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceTypeAndName, "name", variable.OrganizationalUnitName),
+					resource.TestCheckResourceAttr(resourceTypeAndName, "parent_id", variable.ParentID),
+				),
+			},
+			{
+				Config: api.config(updateConfig),
+				Check:  resource.TestCheckResourceAttr(resourceTypeAndName, "name", variable.OrganizationalUnitNameUpdate),
+			},
+			api.importStep(updateConfig, resourceTypeAndName),
+		},
+	})
+}
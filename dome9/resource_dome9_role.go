@@ -6,7 +6,6 @@ import (
 	"strconv"
 	"strings"
 
-	"github.com/dome9/dome9-sdk-go/dome9/client"
 	"github.com/dome9/dome9-sdk-go/services/roles"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 
@@ -95,7 +94,7 @@ func resourceRoleRead(d *schema.ResourceData, meta interface{}) error {
 	d9Client := meta.(*Client)
 	resp, _, err := d9Client.role.Get(d.Id())
 	if err != nil {
-		if err.(*client.ErrorResponse).IsObjectNotFound() {
+		if isNotFoundError(err) {
 			log.Printf("[WARN] Removing role %s from state because it no longer exists in Dome9", d.Id())
 			d.SetId("")
 			return nil
@@ -126,3 +126,32 @@ resource "%s" "%s" {
 		strconv.FormatBool(toPermittedAlertActions),
 	)
 }
+
+func TestResourceRoleLifecycle(t *testing.T) {
+	api := newFakeDome9API(t)
+	resourceTypeAndName, _, generatedName := method.GenerateRandomSourcesTypeAndName(resourcetype.Role)
+	updateConfig := RoleResourceHCL(generatedName, variable.RoleUpdateDescription, variable.RoleUpdateToPermittedAlertActions)
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    api.providers(),
+		CheckDestroy: api.checkDestroyed("role"),
+		Steps: []resource.TestStep{
+			{
+				Config: api.config(testAccCheckRoleConfigure(resourceTypeAndName, generatedName, variable.RoleDescription, variable.RoleToPermittedAlertActions)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceTypeAndName, "name", variable.RoleName),
+					resource.TestCheckResourceAttr(resourceTypeAndName, "description", variable.RoleDescription),
+					resource.TestCheckResourceAttr(resourceTypeAndName, "permit_alert_actions", strconv.FormatBool(variable.RoleToPermittedAlertActions)),
+				),
+			},
+			{
+				Config: api.config(updateConfig),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceTypeAndName, "description", variable.RoleUpdateDescription),
+					resource.TestCheckResourceAttr(resourceTypeAndName, "permit_alert_actions", strconv.FormatBool(variable.RoleUpdateToPermittedAlertActions)),
+				),
+			},
+			api.importStep(updateConfig, resourceTypeAndName),
+		},
+	})
+}
@@ -7,7 +7,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 
-	"github.com/dome9/dome9-sdk-go/dome9/client"
 	"github.com/dome9/dome9-sdk-go/services/rulebundles"
 	"github.com/terraform-providers/terraform-provider-dome9/dome9/common/providerconst"
 )
@@ -21,6 +20,7 @@ func resourceRuleSet() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: resourceRuleSetCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -73,74 +73,218 @@ func resourceRuleSet() *schema.Resource {
 				Computed: true,
 			},
 			"rules": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ConflictsWith: []string{"rules_file", "rules_dir", "source_ruleset_id"},
+				Elem: &schema.Resource{
+					Schema: ruleSetRuleSchema(),
+				},
+			},
+			"rules_file": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"rules", "rules_dir", "source_ruleset_id"},
+			},
+			"rules_dir": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"rules", "rules_file", "source_ruleset_id"},
+			},
+			"file_rules": {
 				Type:     schema.TypeList,
-				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: computedRuleSetRuleSchema(),
+				},
+			},
+			"source_ruleset_id": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ConflictsWith: []string{"rules", "rules_file", "rules_dir"},
+			},
+			"rule_override": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				RequiredWith: []string{"source_ruleset_id"},
+				Elem: &schema.Resource{
+					Schema: ruleSetRuleOverrideSchema(),
+				},
+			},
+			"removed_rule_ids": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				RequiredWith: []string{"source_ruleset_id"},
+				Elem:         &schema.Schema{Type: schema.TypeString},
+			},
+			"cloned_rules": {
+				Type:     schema.TypeList,
+				Computed: true,
 				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"name": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-						"logic": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-						"severity": {
-							Type:     schema.TypeString,
-							Optional: true,
-							Default:  "Low",
-						},
-						"description": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-						"remediation": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-						"compliance_tag": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-						"domain": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-						"priority": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-						"control_title": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-						"rule_id": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-						"category": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-						"logic_hash": {
-							Type:     schema.TypeString,
-							Computed: true,
-						},
-						"is_default": {
-							Type:     schema.TypeBool,
-							Optional: true,
-							Default:  false,
-						},
-					},
+					Schema: computedRuleSetRuleSchema(),
 				},
 			},
 		},
 	}
 }
 
+// ruleSetRuleSchema returns the arguments of a rule, shared by the rules of dome9_ruleset and dome9_ruleset_rule
+func ruleSetRuleSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"logic": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validateGSLRule,
+		},
+		"severity": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  "Low",
+		},
+		"description": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"remediation": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"compliance_tag": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"domain": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"priority": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"control_title": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"rule_id": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"category": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"logic_hash": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"is_default": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+	}
+}
+
+// computedRuleSetRuleSchema returns the attributes of the rules loaded from rules_file or rules_dir, or cloned from
+// source_ruleset_id
+func computedRuleSetRuleSchema() map[string]*schema.Schema {
+	ruleSchema := ruleSetRuleSchema()
+	for key, val := range ruleSchema {
+		ruleSchema[key] = &schema.Schema{
+			Type:     val.Type,
+			Computed: true,
+		}
+	}
+
+	return ruleSchema
+}
+
+// resourceRuleSetCustomizeDiff loads the rules of rules_file or rules_dir into file_rules and the rules cloned from
+// source_ruleset_id into cloned_rules, so that the plan shows the changes of every rule, including the rules added
+// to or changed in the source ruleset
+func resourceRuleSetCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("source_ruleset_id") || !d.NewValueKnown("rule_override") || !d.NewValueKnown("removed_rule_ids") {
+		return d.SetNewComputed("cloned_rules")
+	}
+	if sourceID := d.Get("source_ruleset_id").(int); sourceID != 0 {
+		rules, err := loadRuleSetSourceRules(meta.(*Client), sourceID, d.Get("rule_override").([]interface{}),
+			d.Get("removed_rule_ids").(*schema.Set))
+		if err != nil {
+			return err
+		}
+		keepRuleSetLogicHashes(d, rules)
+
+		return d.SetNew("cloned_rules", flattenRules(rules))
+	}
+
+	if !d.NewValueKnown("rules_file") || !d.NewValueKnown("rules_dir") {
+		return d.SetNewComputed("file_rules")
+	}
+
+	rules, err := loadRuleSetFileRules(d.Get("rules_file").(string), d.Get("rules_dir").(string))
+	if err != nil || rules == nil {
+		return err
+	}
+	keepRuleSetLogicHashes(d, rules)
+
+	return d.SetNew("file_rules", flattenRules(rules))
+}
+
+// keepRuleSetLogicHashes fills the missing logic hashes with the known ones: the logic hash only depends on the logic,
+// the known hashes are kept until Dome9 computes the new ones
+func keepRuleSetLogicHashes(d *schema.ResourceDiff, rules []rulebundles.Rule) {
+	logicHashes := make(map[string]string)
+	for _, key := range ruleSetRulesKeys {
+		old, _ := d.GetChange(key)
+		for _, item := range old.([]interface{}) {
+			if rule, ok := item.(map[string]interface{}); ok {
+				logicHashes[rule["logic"].(string)] = rule["logic_hash"].(string)
+			}
+		}
+	}
+	for i := range rules {
+		if rules[i].LogicHash == "" {
+			rules[i].LogicHash = logicHashes[rules[i].Logic]
+		}
+	}
+}
+
+// ruleSetRulesKeys are the attributes holding the rules of a ruleset, depending on where they come from
+var ruleSetRulesKeys = []string{"rules", "file_rules", "cloned_rules"}
+
+// ruleSetRulesKey returns the attribute holding the rules of the ruleset
+func ruleSetRulesKey(d *schema.ResourceData) string {
+	switch {
+	case d.Get("source_ruleset_id").(int) != 0:
+		return "cloned_rules"
+	case d.Get("rules_file").(string) != "" || d.Get("rules_dir").(string) != "":
+		return "file_rules"
+	}
+
+	return "rules"
+}
+
+// loadRuleSetFileRules returns the rules of rulesFile or rulesDir, nil when the rules are set in HCL
+func loadRuleSetFileRules(rulesFile, rulesDir string) ([]rulebundles.Rule, error) {
+	switch {
+	case rulesFile != "":
+		return loadRuleSetRulesFile(rulesFile)
+	case rulesDir != "":
+		return loadRuleSetRulesDir(rulesDir)
+	}
+
+	return nil, nil
+}
+
 func resourceRuleSetCreate(d *schema.ResourceData, meta interface{}) error {
 	d9Client := meta.(*Client)
-	req := expandRuleSetCreateRequest(d)
+	req, err := expandRuleSetCreateRequest(d, d9Client)
+	if err != nil {
+		return err
+	}
 	log.Printf("[INFO] Creating dome9 rule set with request\n%+v\n", req)
 
 	ruleSet, _, err := d9Client.ruleSet.Create(&req)
@@ -157,7 +301,7 @@ func resourceRuleSetRead(d *schema.ResourceData, meta interface{}) error {
 	d9Client := meta.(*Client)
 	resp, _, err := d9Client.ruleSet.Get(d.Id())
 	if err != nil {
-		if err.(*client.ErrorResponse).IsObjectNotFound() {
+		if isNotFoundError(err) {
 			log.Printf("[WARN] Removing rule set %s from state because it no longer exists in Dome9", d.Id())
 			d.SetId("")
 			return nil
@@ -181,7 +325,15 @@ func resourceRuleSetRead(d *schema.ResourceData, meta interface{}) error {
 	_ = d.Set("language", resp.Language)
 	_ = d.Set("rules_count", resp.RulesCount)
 
-	if err := d.Set("rules", flattenRules(resp.Rules)); err != nil {
+	// the rules loaded from files or cloned are kept apart, the rules argument isn't set in HCL
+	rulesKey := ruleSetRulesKey(d)
+	for _, key := range ruleSetRulesKeys {
+		if key != rulesKey {
+			_ = d.Set(key, nil)
+		}
+	}
+	// the rules of dome9_ruleset_rule are left out, any other rule added out of Terraform shows in the plan
+	if err := d.Set(rulesKey, flattenRules(ruleSetOwnRules(resp.Rules))); err != nil {
 		return err
 	}
 
@@ -195,8 +347,31 @@ func resourceRuleSetUpdate(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	req := expandRuleSetCreateRequest(d)
+	ruleSetMutexKV.Lock(d.Id())
+	defer ruleSetMutexKV.Unlock(d.Id())
+
+	req, err := expandRuleSetCreateRequest(d, d9Client)
+	if err != nil {
+		return err
+	}
 	req.ID = id
+	resp, _, err := d9Client.ruleSet.Get(d.Id())
+	if err != nil {
+		return err
+	}
+	if d.HasChanges("rules", "rules_file", "rules_dir", "file_rules", "source_ruleset_id", "rule_override",
+		"removed_rule_ids", "cloned_rules") {
+		var previous []interface{}
+		for _, key := range ruleSetRulesKeys {
+			old, _ := d.GetChange(key)
+			previous = append(previous, old.([]interface{})...)
+		}
+		rules := append(*req.Rules, unownedRuleSetRules(resp.Rules, expandRuleList(previous), *req.Rules)...)
+		req.Rules = &rules
+	} else {
+		rules := append(make([]rulebundles.Rule, 0, len(resp.Rules)), resp.Rules...)
+		req.Rules = &rules
+	}
 	log.Printf("[INFO] Updating rule set with name %s\n", req.Name)
 
 	if _, _, err := d9Client.ruleSet.Update(&req); err != nil {
@@ -218,41 +393,39 @@ func resourceRuleSetDelete(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func expandRuleSetCreateRequest(d *schema.ResourceData) rulebundles.RuleBundleRequest {
+func expandRuleSetCreateRequest(d *schema.ResourceData, d9Client *Client) (rulebundles.RuleBundleRequest, error) {
+	rules := expandRules(d)
+	fileRules, err := loadRuleSetFileRules(d.Get("rules_file").(string), d.Get("rules_dir").(string))
+	if err != nil {
+		return rulebundles.RuleBundleRequest{}, err
+	}
+	if fileRules != nil {
+		rules = &fileRules
+	}
+	if sourceID := d.Get("source_ruleset_id").(int); sourceID != 0 {
+		clonedRules, err := loadRuleSetSourceRules(d9Client, sourceID, d.Get("rule_override").([]interface{}),
+			d.Get("removed_rule_ids").(*schema.Set))
+		if err != nil {
+			return rulebundles.RuleBundleRequest{}, err
+		}
+		rules = &clonedRules
+	}
+
 	return rulebundles.RuleBundleRequest{
 		Name:             d.Get("name").(string),
 		Description:      d.Get("description").(string),
-		Rules:            expandRules(d),
+		Rules:            rules,
 		HideInCompliance: d.Get("hide_in_compliance").(bool),
 		MinFeatureTier:   d.Get("min_feature_tier").(string),
 		CloudVendor:      d.Get("cloud_vendor").(string),
 		Language:         d.Get("language").(string),
-	}
+	}, nil
 }
 
 func expandRules(d *schema.ResourceData) *[]rulebundles.Rule {
 	var rules []rulebundles.Rule
 	if itemsInterface, ok := d.GetOk("rules"); ok {
-		items := itemsInterface.([]interface{})
-		rules = make([]rulebundles.Rule, len(items))
-		for i, item := range items {
-			rule := item.(map[string]interface{})
-			rules[i] = rulebundles.Rule{
-				Name:          rule["name"].(string),
-				Severity:      rule["severity"].(string),
-				Logic:         rule["logic"].(string),
-				Description:   rule["description"].(string),
-				Remediation:   rule["remediation"].(string),
-				ComplianceTag: rule["compliance_tag"].(string),
-				Domain:        rule["domain"].(string),
-				Priority:      rule["priority"].(string),
-				ControlTitle:  rule["control_title"].(string),
-				RuleID:        rule["rule_id"].(string),
-				Category:      rule["category"].(string),
-				LogicHash:     rule["logic_hash"].(string),
-				IsDefault:     rule["is_default"].(bool),
-			}
-		}
+		rules = expandRuleList(itemsInterface.([]interface{}))
 	}
 
 	if rules == nil {
@@ -262,25 +435,98 @@ func expandRules(d *schema.ResourceData) *[]rulebundles.Rule {
 	return &rules
 }
 
+func expandRuleList(items []interface{}) []rulebundles.Rule {
+	rules := make([]rulebundles.Rule, len(items))
+	for i, item := range items {
+		rules[i] = expandRule(item.(map[string]interface{}))
+	}
+
+	return rules
+}
+
+func expandRule(rule map[string]interface{}) rulebundles.Rule {
+	return rulebundles.Rule{
+		Name:          rule["name"].(string),
+		Severity:      rule["severity"].(string),
+		Logic:         rule["logic"].(string),
+		Description:   rule["description"].(string),
+		Remediation:   rule["remediation"].(string),
+		ComplianceTag: rule["compliance_tag"].(string),
+		Domain:        rule["domain"].(string),
+		Priority:      rule["priority"].(string),
+		ControlTitle:  rule["control_title"].(string),
+		RuleID:        rule["rule_id"].(string),
+		Category:      rule["category"].(string),
+		LogicHash:     rule["logic_hash"].(string),
+		IsDefault:     rule["is_default"].(bool),
+	}
+}
+
+// ruleSetOwnRules returns the rules of the rule set which aren't managed by dome9_ruleset_rule
+func ruleSetOwnRules(rules []rulebundles.Rule) []rulebundles.Rule {
+	own := make([]rulebundles.Rule, 0, len(rules))
+	for _, rule := range rules {
+		if !isRuleSetRuleResource(rule) {
+			own = append(own, rule)
+		}
+	}
+
+	return own
+}
+
+// unownedRuleSetRules returns the rules of the rule set which an update of its rules argument keeps: the rules of
+// dome9_ruleset_rule, and the rules which were neither in the previous rules nor are in the new ones
+func unownedRuleSetRules(rules, oldRules, newRules []rulebundles.Rule) []rulebundles.Rule {
+	var unowned []rulebundles.Rule
+	for _, rule := range rules {
+		if isRuleSetRuleResource(rule) || !containsRuleSetRule(oldRules, rule) && !containsRuleSetRule(newRules, rule) {
+			unowned = append(unowned, rule)
+		}
+	}
+
+	return unowned
+}
+
+// containsRuleSetRule reports whether rule is one of rules, identified by its rule ID or, without one, by its name
+// and logic or by its logic hash
+func containsRuleSetRule(rules []rulebundles.Rule, rule rulebundles.Rule) bool {
+	for _, val := range rules {
+		switch {
+		case val.RuleID != "" || rule.RuleID != "":
+			if val.RuleID == rule.RuleID {
+				return true
+			}
+		case val.Name == rule.Name && val.Logic == rule.Logic, val.LogicHash != "" && val.LogicHash == rule.LogicHash:
+			return true
+		}
+	}
+
+	return false
+}
+
 func flattenRules(responseRules []rulebundles.Rule) []interface{} {
 	rules := make([]interface{}, len(responseRules))
 	for i, val := range responseRules {
-		rules[i] = map[string]interface{}{
-			"name":           val.Name,
-			"severity":       val.Severity,
-			"logic":          val.Logic,
-			"description":    val.Description,
-			"remediation":    val.Remediation,
-			"compliance_tag": val.ComplianceTag,
-			"domain":         val.Domain,
-			"priority":       val.Priority,
-			"control_title":  val.ControlTitle,
-			"rule_id":        val.RuleID,
-			"category":       val.Category,
-			"logic_hash":     val.LogicHash,
-			"is_default":     val.IsDefault,
-		}
+		rules[i] = flattenRule(val)
 	}
 
 	return rules
 }
+
+func flattenRule(rule rulebundles.Rule) map[string]interface{} {
+	return map[string]interface{}{
+		"name":           rule.Name,
+		"severity":       rule.Severity,
+		"logic":          rule.Logic,
+		"description":    rule.Description,
+		"remediation":    rule.Remediation,
+		"compliance_tag": rule.ComplianceTag,
+		"domain":         rule.Domain,
+		"priority":       rule.Priority,
+		"control_title":  rule.ControlTitle,
+		"rule_id":        rule.RuleID,
+		"category":       rule.Category,
+		"logic_hash":     rule.LogicHash,
+		"is_default":     rule.IsDefault,
+	}
+}
@@ -0,0 +1,326 @@
+package dome9
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/mutexkv"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/dome9/dome9-sdk-go/services/rulebundles"
+)
+
+// ruleSetMutexKV serializes the changes to the rules of a rule set, the API only updates a rule set as a whole
+var ruleSetMutexKV = mutexkv.NewMutexKV()
+
+// ruleSetRuleLabel marks the rules managed by dome9_ruleset_rule, which dome9_ruleset leaves out of its rules
+const ruleSetRuleLabel = "terraform:dome9_ruleset_rule"
+
+func resourceRuleSetRule() *schema.Resource {
+	ruleSchema := ruleSetRuleSchema()
+	ruleSchema["ruleset_id"] = &schema.Schema{
+		Type:     schema.TypeInt,
+		Required: true,
+		ForceNew: true,
+	}
+	ruleSchema["rule_id"].ForceNew = true
+
+	return &schema.Resource{
+		Create: resourceRuleSetRuleCreate,
+		Read:   resourceRuleSetRuleRead,
+		Update: resourceRuleSetRuleUpdate,
+		Delete: resourceRuleSetRuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceRuleSetRuleImport,
+		},
+
+		Schema: ruleSchema,
+	}
+}
+
+func resourceRuleSetRuleCreate(d *schema.ResourceData, meta interface{}) error {
+	d9Client := meta.(*Client)
+	ruleSetID := strconv.Itoa(d.Get("ruleset_id").(int))
+	rule := expandRuleSetRule(d)
+
+	ruleSetMutexKV.Lock(ruleSetID)
+	defer ruleSetMutexKV.Unlock(ruleSetID)
+
+	ruleSet, _, err := d9Client.ruleSet.Get(ruleSetID)
+	if err != nil {
+		return err
+	}
+	if rule.RuleID != "" && findRuleSetRule(ruleSet.Rules, rule.RuleID) >= 0 {
+		return fmt.Errorf("rule %s already exists in rule set %s, import it instead", rule.RuleID, ruleSetID)
+	}
+
+	log.Printf("[INFO] Adding rule %s to rule set %s\n", rule.Name, ruleSetID)
+	resp, err := updateRuleSetRules(d9Client, ruleSet, append(ruleSet.Rules, rule))
+	if err != nil {
+		return err
+	}
+
+	key := ruleSetRuleKey(resp.Rules, ruleSet.Rules, rule)
+	if key == "" {
+		return fmt.Errorf("rule %s was added to rule set %s but isn't returned by Dome9", rule.Name, ruleSetID)
+	}
+
+	d.SetId(ruleSetRuleID(ruleSetID, key))
+	return resourceRuleSetRuleRead(d, meta)
+}
+
+func resourceRuleSetRuleRead(d *schema.ResourceData, meta interface{}) error {
+	d9Client := meta.(*Client)
+	ruleSetID, key, err := parseRuleSetRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	ruleSet, _, err := d9Client.ruleSet.Get(ruleSetID)
+	if err != nil {
+		if isNotFoundError(err) {
+			log.Printf("[WARN] Removing rule set rule %s from state because its rule set no longer exists in Dome9", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		return err
+	}
+
+	i := findRuleSetRule(ruleSet.Rules, key)
+	if i < 0 {
+		log.Printf("[WARN] Removing rule set rule %s from state because it no longer exists in Dome9", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	log.Printf("[INFO] Getting rule set rule:\n%+v\n", ruleSet.Rules[i])
+	_ = d.Set("ruleset_id", ruleSet.ID)
+	for key, value := range flattenRule(ruleSet.Rules[i]) {
+		_ = d.Set(key, value)
+	}
+
+	return nil
+}
+
+func resourceRuleSetRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+	d9Client := meta.(*Client)
+	ruleSetID, key, err := parseRuleSetRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+	rule := expandRuleSetRule(d)
+
+	ruleSetMutexKV.Lock(ruleSetID)
+	defer ruleSetMutexKV.Unlock(ruleSetID)
+
+	ruleSet, _, err := d9Client.ruleSet.Get(ruleSetID)
+	if err != nil {
+		return err
+	}
+	i := findRuleSetRule(ruleSet.Rules, key)
+	if i < 0 {
+		return fmt.Errorf("rule %s no longer exists in rule set %s", key, ruleSetID)
+	}
+
+	// Dome9 computes a new logic hash when the logic changes
+	rule.LogicHash = ""
+	rule.Labels = withRuleSetRuleLabel(ruleSet.Rules[i].Labels)
+	rules := append([]rulebundles.Rule{}, ruleSet.Rules...)
+	rules[i] = rule
+
+	log.Printf("[INFO] Updating rule %s of rule set %s\n", key, ruleSetID)
+	resp, err := updateRuleSetRules(d9Client, ruleSet, rules)
+	if err != nil {
+		return err
+	}
+
+	otherRules := append(append([]rulebundles.Rule{}, ruleSet.Rules[:i]...), ruleSet.Rules[i+1:]...)
+	newKey := ruleSetRuleKey(resp.Rules, otherRules, rule)
+	if newKey == "" {
+		return fmt.Errorf("rule %s was updated in rule set %s but isn't returned by Dome9", key, ruleSetID)
+	}
+
+	d.SetId(ruleSetRuleID(ruleSetID, newKey))
+
+	return resourceRuleSetRuleRead(d, meta)
+}
+
+func resourceRuleSetRuleDelete(d *schema.ResourceData, meta interface{}) error {
+	d9Client := meta.(*Client)
+	ruleSetID, key, err := parseRuleSetRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	ruleSetMutexKV.Lock(ruleSetID)
+	defer ruleSetMutexKV.Unlock(ruleSetID)
+
+	ruleSet, _, err := d9Client.ruleSet.Get(ruleSetID)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil
+		}
+
+		return err
+	}
+	i := findRuleSetRule(ruleSet.Rules, key)
+	if i < 0 {
+		return nil
+	}
+
+	log.Printf("[INFO] Removing rule %s from rule set %s\n", key, ruleSetID)
+	rules := append(append([]rulebundles.Rule{}, ruleSet.Rules[:i]...), ruleSet.Rules[i+1:]...)
+	if _, err := updateRuleSetRules(d9Client, ruleSet, rules); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// resourceRuleSetRuleImport marks the imported rule as managed by dome9_ruleset_rule, so that dome9_ruleset leaves it
+// out of its rules
+func resourceRuleSetRuleImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d9Client := meta.(*Client)
+	ruleSetID, key, err := parseRuleSetRuleID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	ruleSetMutexKV.Lock(ruleSetID)
+	defer ruleSetMutexKV.Unlock(ruleSetID)
+
+	ruleSet, _, err := d9Client.ruleSet.Get(ruleSetID)
+	if err != nil {
+		return nil, err
+	}
+	i := findRuleSetRule(ruleSet.Rules, key)
+	if i < 0 {
+		return nil, fmt.Errorf("rule %s doesn't exist in rule set %s", key, ruleSetID)
+	}
+
+	if !isRuleSetRuleResource(ruleSet.Rules[i]) {
+		log.Printf("[INFO] Marking rule %s of rule set %s as managed by %s\n", key, ruleSetID, ruleSetRuleLabel)
+		rules := append([]rulebundles.Rule{}, ruleSet.Rules...)
+		rules[i].Labels = withRuleSetRuleLabel(rules[i].Labels)
+		if _, err := updateRuleSetRules(d9Client, ruleSet, rules); err != nil {
+			return nil, err
+		}
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func expandRuleSetRule(d *schema.ResourceData) rulebundles.Rule {
+	rule := make(map[string]interface{})
+	for key := range ruleSetRuleSchema() {
+		rule[key] = d.Get(key)
+	}
+
+	ruleSetRule := expandRule(rule)
+	ruleSetRule.Labels = withRuleSetRuleLabel(nil)
+
+	return ruleSetRule
+}
+
+// updateRuleSetRules replaces the rules of the rule set, keeping its other settings
+func updateRuleSetRules(d9Client *Client, ruleSet *rulebundles.RuleBundleResponse, rules []rulebundles.Rule) (*rulebundles.RuleBundleResponse, error) {
+	req := rulebundles.RuleBundleRequest{
+		ID:               ruleSet.ID,
+		Name:             ruleSet.Name,
+		Description:      ruleSet.Description,
+		Rules:            &rules,
+		HideInCompliance: ruleSet.HideInCompliance,
+		MinFeatureTier:   ruleSet.MinFeatureTier,
+		CloudVendor:      ruleSet.CloudVendor,
+		Language:         ruleSet.Language,
+	}
+
+	resp, _, err := d9Client.ruleSet.Update(&req)
+	return resp, err
+}
+
+// findRuleSetRule returns the index of the rule whose rule ID, or logic hash, is key and -1 when there is none
+func findRuleSetRule(rules []rulebundles.Rule, key string) int {
+	for i, rule := range rules {
+		if rule.RuleID == key {
+			return i
+		}
+	}
+	for i, rule := range rules {
+		if rule.RuleID == "" && rule.LogicHash == key {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// ruleSetRuleKey returns the key of rule among the rules returned by Dome9 after a change: its rule ID or, without
+// one, the logic hash Dome9 computed for it, which isn't one of the other rules of the rule set. It returns "" when
+// the rules miss it.
+func ruleSetRuleKey(rules, otherRules []rulebundles.Rule, rule rulebundles.Rule) string {
+	for _, val := range rules {
+		switch {
+		case rule.RuleID != "":
+			if val.RuleID == rule.RuleID {
+				return val.RuleID
+			}
+		case val.RuleID == "" && val.Name == rule.Name && val.Logic == rule.Logic && !ruleSetRuleExists(otherRules, val.LogicHash):
+			return val.LogicHash
+		}
+	}
+
+	return ""
+}
+
+// withRuleSetRuleLabel returns labels with the label marking the rules of dome9_ruleset_rule
+func withRuleSetRuleLabel(labels []string) []string {
+	for _, label := range labels {
+		if label == ruleSetRuleLabel {
+			return labels
+		}
+	}
+
+	return append(append([]string{}, labels...), ruleSetRuleLabel)
+}
+
+// isRuleSetRuleResource reports whether rule is managed by dome9_ruleset_rule
+func isRuleSetRuleResource(rule rulebundles.Rule) bool {
+	for _, label := range rule.Labels {
+		if label == ruleSetRuleLabel {
+			return true
+		}
+	}
+
+	return false
+}
+
+func ruleSetRuleExists(rules []rulebundles.Rule, logicHash string) bool {
+	for _, rule := range rules {
+		if rule.LogicHash == logicHash {
+			return true
+		}
+	}
+
+	return false
+}
+
+func ruleSetRuleID(ruleSetID, key string) string {
+	return ruleSetID + "/" + key
+}
+
+// parseRuleSetRuleID splits <ruleset_id>/<rule_id>, the logic hash standing for the rule ID of the rules without one
+func parseRuleSetRuleID(id string) (string, string, error) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%s), expected <ruleset_id>/<rule_id>", id)
+	}
+	if _, err := strconv.Atoi(parts[0]); err != nil {
+		return "", "", fmt.Errorf("invalid rule set ID in %s: %s", id, err)
+	}
+
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,197 @@
+package dome9
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/dome9/dome9-sdk-go/services/rulebundles"
+
+	"github.com/terraform-providers/terraform-provider-dome9/dome9/common/resourcetype"
+	"github.com/terraform-providers/terraform-provider-dome9/dome9/common/testing/method"
+	"github.com/terraform-providers/terraform-provider-dome9/dome9/common/testing/variable"
+)
+
+func TestAccResourceRuleSetRuleBasic(t *testing.T) {
+	var rule rulebundles.Rule
+	resourceTypeAndName, _, generatedName := method.GenerateRandomSourcesTypeAndName(resourcetype.RuleSetRule)
+	_, _, ruleSetGeneratedName := method.GenerateRandomSourcesTypeAndName(resourcetype.RuleSet)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRuleSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: getRuleSetRuleResourceHCL(generatedName, ruleSetGeneratedName, variable.RuleSetRuleLogic, variable.RuleSetRuleSeverity),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRuleSetRuleExists(resourceTypeAndName, &rule),
+					resource.TestCheckResourceAttr(resourceTypeAndName, "rule_id", variable.RuleSetRuleID),
+					resource.TestCheckResourceAttr(resourceTypeAndName, "logic", variable.RuleSetRuleLogic),
+					resource.TestCheckResourceAttr(resourceTypeAndName, "severity", variable.RuleSetRuleSeverity),
+				),
+			},
+
+			// Update test
+			{
+				Config: getRuleSetRuleResourceHCL(generatedName, ruleSetGeneratedName, variable.RuleSetRuleLogicUpdate, variable.RuleSetRuleSeverityUpdate),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRuleSetRuleExists(resourceTypeAndName, &rule),
+					resource.TestCheckResourceAttr(resourceTypeAndName, "logic", variable.RuleSetRuleLogicUpdate),
+					resource.TestCheckResourceAttr(resourceTypeAndName, "severity", variable.RuleSetRuleSeverityUpdate),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceRuleSetRuleLifecycle(t *testing.T) {
+	api := newFakeDome9API(t)
+	resourceTypeAndName, _, generatedName := method.GenerateRandomSourcesTypeAndName(resourcetype.RuleSetRule)
+	_, _, ruleSetGeneratedName := method.GenerateRandomSourcesTypeAndName(resourcetype.RuleSet)
+	ruleSetTypeAndName := resourcetype.RuleSet + "." + ruleSetGeneratedName
+	hashedTypeAndName := resourceTypeAndName + "_hashed"
+	updateConfig := getRuleSetRuleResourceHCL(generatedName, ruleSetGeneratedName, variable.RuleSetRuleLogicUpdate, variable.RuleSetRuleSeverityUpdate)
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    api.providers(),
+		CheckDestroy: api.checkDestroyed("Compliance/Ruleset"),
+		Steps: []resource.TestStep{
+			{
+				Config: api.config(getRuleSetRuleResourceHCL(generatedName, ruleSetGeneratedName, variable.RuleSetRuleLogic, variable.RuleSetRuleSeverity)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceTypeAndName, "ruleset_id", ruleSetTypeAndName, "id"),
+					resource.TestCheckResourceAttr(resourceTypeAndName, "rule_id", variable.RuleSetRuleID),
+					resource.TestCheckResourceAttr(resourceTypeAndName, "severity", variable.RuleSetRuleSeverity),
+					resource.TestCheckResourceAttrSet(hashedTypeAndName, "logic_hash"),
+					resource.TestCheckResourceAttr(hashedTypeAndName, "rule_id", ""),
+				),
+			},
+			{
+				Config: api.config(updateConfig),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceTypeAndName, "logic", variable.RuleSetRuleLogicUpdate),
+					resource.TestCheckResourceAttr(resourceTypeAndName, "severity", variable.RuleSetRuleSeverityUpdate),
+					resource.TestCheckResourceAttr(hashedTypeAndName, "severity", variable.RuleSetRuleSeverityUpdate),
+					// the update of the rule of the rule set keeps the rules of dome9_ruleset_rule
+					resource.TestCheckResourceAttr(ruleSetTypeAndName, "rules.#", "1"),
+					resource.TestCheckResourceAttr(ruleSetTypeAndName, "rules.0.severity", variable.RuleSetRuleSeverityUpdate),
+					resource.TestCheckResourceAttr(ruleSetTypeAndName, "rules_count", "3"),
+				),
+			},
+			{
+				// a rule added out of Terraform shows in the rules of the rule set, which removes it
+				PreConfig: func() {
+					api.mu.Lock()
+					defer api.mu.Unlock()
+					for _, ruleSet := range api.collection("Compliance/Ruleset").objects {
+						ruleSet["rules"] = append(ruleSet["rules"].([]interface{}), map[string]interface{}{
+							"name": "added out of terraform", "logic": "ELB should have isPublic=false", "logicHash": "0000abcd",
+						})
+					}
+				},
+				Config:             api.config(updateConfig),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: api.config(updateConfig),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(ruleSetTypeAndName, "rules.#", "1"),
+					resource.TestCheckResourceAttr(ruleSetTypeAndName, "rules_count", "3"),
+				),
+			},
+			api.importStep(updateConfig, resourceTypeAndName),
+			api.importStep(updateConfig, hashedTypeAndName),
+		},
+	})
+}
+
+func testAccCheckRuleSetRuleExists(resource string, rule *rulebundles.Rule) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resource]
+		if !ok {
+			return fmt.Errorf("didn't find resource: %s", resource)
+		}
+		ruleSetID, key, err := parseRuleSetRuleID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		apiClient := testAccProvider.Meta().(*Client)
+		receivedRuleSet, _, err := apiClient.ruleSet.Get(ruleSetID)
+		if err != nil {
+			return fmt.Errorf("failed fetching resource %s. Recevied error: %s", resource, err)
+		}
+		i := findRuleSetRule(receivedRuleSet.Rules, key)
+		if i < 0 {
+			return fmt.Errorf("rule %s not found in rule set %s", key, ruleSetID)
+		}
+		*rule = receivedRuleSet.Rules[i]
+
+		return nil
+	}
+}
+
+// getRuleSetRuleResourceHCL returns a rule set managing a rule of its own next to a rule keyed by rule_id and a rule
+// keyed by its logic hash
+func getRuleSetRuleResourceHCL(generatedName, ruleSetGeneratedName, logic, severity string) string {
+	return fmt.Sprintf(`
+resource "%s" "%s" {
+  name               = "%s"
+  description        = "%s"
+  cloud_vendor       = "aws"
+  language           = "en"
+  hide_in_compliance = false
+
+  rules {
+    name     = "%s_owned"
+    logic    = "Instance should have isPublic=false"
+    severity = "%s"
+  }
+}
+
+resource "%s" "%s" {
+  ruleset_id = "${%s.%s.id}"
+  rule_id    = "%s"
+  name       = "%s"
+  logic      = "%s"
+  severity   = "%s"
+}
+
+resource "%s" "%s_hashed" {
+  ruleset_id = "${%s.%s.id}"
+  name       = "%s_hashed"
+  logic      = "VPC should have cidr"
+  severity   = "%s"
+}
+`,
+		// ruleset variables
+		resourcetype.RuleSet,
+		ruleSetGeneratedName,
+		ruleSetGeneratedName,
+		variable.RuleSetDescription,
+		generatedName,
+		severity,
+
+		// rule keyed by rule_id variables
+		resourcetype.RuleSetRule,
+		generatedName,
+		resourcetype.RuleSet,
+		ruleSetGeneratedName,
+		variable.RuleSetRuleID,
+		generatedName,
+		logic,
+		severity,
+
+		// rule keyed by logic hash variables
+		resourcetype.RuleSetRule,
+		generatedName,
+		resourcetype.RuleSet,
+		ruleSetGeneratedName,
+		generatedName,
+		severity,
+	)
+}
@@ -93,6 +93,23 @@ func testAccCheckRuleSetExists(resource string, ruleSet *rulebundles.RuleBundleR
 
 func testAccCheckRuleSetConfigure(resourceTypeAndName, generatedName, description string) string {
 	return fmt.Sprintf(`
+%s
+data "%s" "%s" {
+	id = "${%s.id}"
+}
+`,
+		// resource variables
+		getRuleSetResourceHCL(generatedName, description),
+
+		// data source variables
+		resourcetype.RuleSet,
+		generatedName,
+		resourceTypeAndName,
+	)
+}
+
+func getRuleSetResourceHCL(generatedName, description string) string {
+	return fmt.Sprintf(`
 resource "%s" "%s" {
 	name               = "%s"
 	description        = "%s"
@@ -113,19 +130,202 @@ resource "%s" "%s" {
 	}
 }
 
-data "%s" "%s" {
-	id = "${%s.id}"
-}
 `,
 		// resource variables
 		resourcetype.RuleSet,
 		generatedName,
 		generatedName,
 		description,
+	)
+}
 
-		// data source variables
+func TestResourceRuleSetLifecycle(t *testing.T) {
+	api := newFakeDome9API(t)
+	resourceTypeAndName, _, generatedName := method.GenerateRandomSourcesTypeAndName(resourcetype.RuleSet)
+	updateConfig := getRuleSetResourceHCL(generatedName, variable.RuleSetDescriptionUpdate)
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    api.providers(),
+		CheckDestroy: api.checkDestroyed("Compliance/Ruleset"),
+		Steps: []resource.TestStep{
+			{
+				Config: api.config(testAccCheckRuleSetConfigure(resourceTypeAndName, generatedName, variable.RuleSetDescription)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceTypeAndName, "name", generatedName),
+					resource.TestCheckResourceAttr(resourceTypeAndName, "description", variable.RuleSetDescription),
+					resource.TestCheckResourceAttr(resourceTypeAndName, "rules.#", "1"),
+				),
+			},
+			{
+				Config: api.config(updateConfig),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceTypeAndName, "description", variable.RuleSetDescriptionUpdate),
+					resource.TestCheckResourceAttr(resourceTypeAndName, "rules.#", "1"),
+				),
+			},
+			api.importStep(updateConfig, resourceTypeAndName),
+		},
+	})
+}
+
+func TestUnownedRuleSetRules(t *testing.T) {
+	owned := rulebundles.Rule{RuleID: "OWNED.1", Name: "owned", Logic: "Instance should have isPublic=false", LogicHash: "a"}
+	changed := rulebundles.Rule{Name: "changed", Logic: "VPC should have cidr", LogicHash: "b"}
+	added := rulebundles.Rule{RuleID: "OWNED.2", Name: "added", Logic: "VPC should have cidr", LogicHash: "c"}
+	other := rulebundles.Rule{RuleID: "TEAM.A.1", Name: "other", Logic: "Instance should have isPublic=false", LogicHash: "d"}
+	// a rule moved from the rules of the rule set to dome9_ruleset_rule
+	moved := rulebundles.Rule{Name: "moved", Logic: "KMS should have rotationStatus=true", LogicHash: "e", Labels: []string{ruleSetRuleLabel}}
+
+	oldRules := []rulebundles.Rule{owned, changed, {Name: "moved", Logic: "KMS should have rotationStatus=true"}}
+	newRules := []rulebundles.Rule{owned, {Name: "changed", Logic: "VPC should have cidr"}, {RuleID: "OWNED.2", Name: "added"}}
+	got := unownedRuleSetRules([]rulebundles.Rule{owned, changed, added, other, moved}, oldRules, newRules)
+	if len(got) != 2 || got[0].Name != "other" || got[1].Name != "moved" {
+		t.Errorf("got rules %+v, want the other and moved rules", got)
+	}
+}
+
+func TestRuleSetOwnRules(t *testing.T) {
+	rules := []rulebundles.Rule{
+		{Name: "rule set", Labels: []string{"team-a"}},
+		{Name: "ruleset rule", Labels: withRuleSetRuleLabel([]string{"team-a"})},
+		{Name: "out of terraform"},
+	}
+	got := ruleSetOwnRules(rules)
+	if len(got) != 2 || got[0].Name != "rule set" || got[1].Name != "out of terraform" {
+		t.Errorf("got rules %+v, want the rules not managed by dome9_ruleset_rule", got)
+	}
+}
+
+func TestResourceRuleSetRulesDirLifecycle(t *testing.T) {
+	api := newFakeDome9API(t)
+	resourceTypeAndName, _, generatedName := method.GenerateRandomSourcesTypeAndName(resourcetype.RuleSet)
+	rulesDir := t.TempDir()
+	config := getRuleSetRulesDirResourceHCL(generatedName, rulesDir)
+	writeRulesFile(t, rulesDir, "a.yaml", "- name: first\n  logic: Instance should have vpc\n  severity: High\n")
+	writeRulesFile(t, rulesDir, "b.json", `[{"name": "second", "logic": "VPC should have cidr", "ruleId": "D9.AT.2"}]`)
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    api.providers(),
+		CheckDestroy: api.checkDestroyed("Compliance/Ruleset"),
+		Steps: []resource.TestStep{
+			{
+				Config: api.config(config),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceTypeAndName, "rules.#", "0"),
+					resource.TestCheckResourceAttr(resourceTypeAndName, "file_rules.#", "2"),
+					resource.TestCheckResourceAttr(resourceTypeAndName, "file_rules.0.severity", "High"),
+					resource.TestCheckResourceAttr(resourceTypeAndName, "file_rules.1.rule_id", "D9.AT.2"),
+					resource.TestCheckResourceAttrSet(resourceTypeAndName, "file_rules.1.logic_hash"),
+				),
+			},
+			{
+				PreConfig: func() {
+					writeRulesFile(t, rulesDir, "a.yaml", "- name: first\n  logic: Instance should have vpc\n  severity: Critical\n")
+				},
+				Config: api.config(config),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceTypeAndName, "file_rules.#", "2"),
+					resource.TestCheckResourceAttr(resourceTypeAndName, "file_rules.0.severity", "Critical"),
+				),
+			},
+			api.importStep(config, resourceTypeAndName, "rules", "file_rules", "rules_dir"),
+		},
+	})
+}
+
+func getRuleSetRulesDirResourceHCL(generatedName, rulesDir string) string {
+	return fmt.Sprintf(`
+resource "%s" "%s" {
+	name               = "%s"
+	cloud_vendor       = "aws"
+	language           = "en"
+	hide_in_compliance = false
+	rules_dir          = "%s"
+}
+`,
+		// resource variables
 		resourcetype.RuleSet,
 		generatedName,
-		resourceTypeAndName,
+		generatedName,
+		rulesDir,
+	)
+}
+
+func TestResourceRuleSetCloneLifecycle(t *testing.T) {
+	api := newFakeDome9API(t)
+	resourceTypeAndName, _, generatedName := method.GenerateRandomSourcesTypeAndName(resourcetype.RuleSet)
+	sourceID := api.seed("Compliance/Ruleset", fakeObject{
+		"name":         "AWS CIS Foundations",
+		"cloudVendor":  "aws",
+		"systemBundle": true,
+		"rules": []interface{}{
+			map[string]interface{}{"name": "first", "logic": "Instance should have vpc", "severity": "Low", "ruleId": "D9.AT.1"},
+			map[string]interface{}{"name": "second", "logic": "VPC should have cidr", "severity": "Low", "ruleId": "D9.AT.2"},
+			map[string]interface{}{"name": "third", "logic": "KMS should have rotationStatus=true", "severity": "Low", "ruleId": "D9.AT.3"},
+		},
+	})
+	config := getRuleSetCloneResourceHCL(generatedName, sourceID)
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    api.providers(),
+		CheckDestroy: api.checkDestroyed("Compliance/Ruleset"),
+		Steps: []resource.TestStep{
+			{
+				Config: api.config(config),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceTypeAndName, "rules_count", "2"),
+					resource.TestCheckResourceAttr(resourceTypeAndName, "cloned_rules.#", "2"),
+					resource.TestCheckResourceAttr(resourceTypeAndName, "cloned_rules.0.rule_id", "D9.AT.1"),
+					resource.TestCheckResourceAttr(resourceTypeAndName, "cloned_rules.1.rule_id", "D9.AT.3"),
+					resource.TestCheckResourceAttr(resourceTypeAndName, "cloned_rules.1.severity", "High"),
+					resource.TestCheckResourceAttrSet(resourceTypeAndName, "cloned_rules.1.logic_hash"),
+				),
+			},
+			{
+				// the rules added to the source ruleset show in the plan
+				PreConfig: func() {
+					api.edit("Compliance/Ruleset", sourceID, func(obj fakeObject) {
+						obj["rules"] = append(obj["rules"].([]interface{}), map[string]interface{}{
+							"name": "fourth", "logic": "ELB should have isPublic=false", "severity": "Low", "ruleId": "D9.AT.4",
+						})
+					})
+				},
+				Config:             api.config(config),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: api.config(config),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceTypeAndName, "rules_count", "3"),
+					resource.TestCheckResourceAttr(resourceTypeAndName, "cloned_rules.2.rule_id", "D9.AT.4"),
+				),
+			},
+			api.importStep(config, resourceTypeAndName, "rules", "cloned_rules", "source_ruleset_id", "rule_override", "removed_rule_ids"),
+		},
+	})
+}
+
+func getRuleSetCloneResourceHCL(generatedName, sourceID string) string {
+	return fmt.Sprintf(`
+resource "%s" "%s" {
+	name               = "%s"
+	cloud_vendor       = "aws"
+	language           = "en"
+	hide_in_compliance = false
+	source_ruleset_id  = %s
+	removed_rule_ids   = ["D9.AT.2"]
+
+	rule_override {
+		rule_id  = "D9.AT.3"
+		severity = "High"
+	}
+}
+`,
+		// resource variables
+		resourcetype.RuleSet,
+		generatedName,
+		generatedName,
+		sourceID,
 	)
 }
@@ -1,7 +1,6 @@
 package dome9
 
 import (
-	"github.com/dome9/dome9-sdk-go/dome9/client"
 	"github.com/dome9/dome9-sdk-go/services/serviceaccounts"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"log"
@@ -68,7 +67,7 @@ func resourceServiceAccountRead(d *schema.ResourceData, meta interface{}) error
 
 	resp, _, err := d9Client.serviceAccounts.Get(d.Id())
 	if err != nil {
-		if err.(*client.ErrorResponse).IsObjectNotFound() {
+		if isNotFoundError(err) {
 			log.Printf("[WARN] Removing service account %s from state because it no longer exists in Dome9", d.Id())
 			d.SetId("")
 			return nil
@@ -44,6 +44,41 @@ func TestAccResourceServiceAccountBasic(t *testing.T) {
 	})
 }
 
+func TestResourceServiceAccountLifecycle(t *testing.T) {
+	api := newFakeDome9API(t)
+	serviceAccountTypeAndName, _, serviceAccountGeneratedName := method.GenerateRandomSourcesTypeAndName(resourcetype.ServiceAccount)
+	roleTypeAndName, _, roleGeneratedName := method.GenerateRandomSourcesTypeAndName(resourcetype.Role)
+	roleHCL := testAccCheckRoleConfigure(roleTypeAndName, roleGeneratedName, variable.RoleDescription, variable.RoleToPermittedAlertActions)
+	// without the data source, which has the type and ID of the resource and would be compared on import
+	updateConfig := roleHCL + getServiceAccountResourceHCL(serviceAccountGeneratedName, variable.ServiceAccountNameUpdate, roleTypeAndName)
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: api.providers(),
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			api.checkDestroyed("service-account"),
+			api.checkDestroyed("role"),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: api.config(testAccCheckServiceAccountBasic(serviceAccountTypeAndName, serviceAccountGeneratedName, variable.ServiceAccountName, roleHCL, roleTypeAndName)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(serviceAccountTypeAndName, "name", variable.ServiceAccountName),
+					resource.TestCheckResourceAttr(serviceAccountTypeAndName, "role_ids.#", "1"),
+					resource.TestCheckResourceAttrSet(serviceAccountTypeAndName, "api_key_id"),
+					resource.TestCheckResourceAttrSet(serviceAccountTypeAndName, "api_key_secret"),
+					resource.TestCheckResourceAttrPair("data."+serviceAccountTypeAndName, "api_key_id", serviceAccountTypeAndName, "api_key_id"),
+				),
+			},
+			{
+				Config: api.config(updateConfig),
+				Check:  resource.TestCheckResourceAttr(serviceAccountTypeAndName, "name", variable.ServiceAccountNameUpdate),
+			},
+			// the secret of the key is only returned when the service account is created
+			api.importStep(updateConfig, serviceAccountTypeAndName, "api_key_secret"),
+		},
+	})
+}
+
 func testAccCheckServiceAccountExists(resource string, serviceAccount *serviceaccounts.GetServiceAccountResponse) resource.TestCheckFunc {
 	return func(state *terraform.State) error {
 		rs, ok := state.RootModule().Resources[resource]
@@ -6,7 +6,6 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 
-	"github.com/dome9/dome9-sdk-go/dome9/client"
 	"github.com/dome9/dome9-sdk-go/services/users"
 )
 
@@ -219,7 +218,7 @@ func resourceUserRead(d *schema.ResourceData, meta interface{}) error {
 	resp, _, err := d9Client.users.Get(d.Id())
 
 	if err != nil {
-		if err.(*client.ErrorResponse).IsObjectNotFound() {
+		if isNotFoundError(err) {
 			log.Printf("[WARN] Removing user %s from state because it no longer exists in Dome9", d.Id())
 			d.SetId("")
 			return nil
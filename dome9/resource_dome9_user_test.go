@@ -49,6 +49,38 @@ func TestAccResourceUsersBasic(t *testing.T) {
 	})
 }
 
+func TestResourceUserLifecycle(t *testing.T) {
+	api := newFakeDome9API(t)
+	resourceTypeAndName, _, generatedName := method.GenerateRandomSourcesTypeAndName(resourcetype.User)
+	roleTypeAndName, _, roleName := method.GenerateRandomSourcesTypeAndName(resourcetype.Role)
+	updateConfig := getUserUpdateResourceHCL(RoleResourceHCL(roleName, variable.RoleDescription, variable.RoleToPermittedAlertActions), roleTypeAndName, generatedName)
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    api.providers(),
+		CheckDestroy: api.checkDestroyed("user"),
+		Steps: []resource.TestStep{
+			{
+				Config: api.config(testAccCheckUsersConfigure(resourceTypeAndName, generatedName)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceTypeAndName, "email", composeGenerateEmail(generatedName)),
+					resource.TestCheckResourceAttr(resourceTypeAndName, "is_sso_enabled", strconv.FormatBool(variable.UserIsSsoEnabled)),
+					resource.TestCheckResourceAttr("data."+resourceTypeAndName, "email", composeGenerateEmail(generatedName)),
+				),
+			},
+			{
+				Config: api.config(updateConfig),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceTypeAndName, "role_ids.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceTypeAndName, "role_ids.0", roleTypeAndName, "id"),
+					resource.TestCheckResourceAttr(resourceTypeAndName, "permit_alert_actions", strconv.FormatBool(variable.RoleUpdateToPermittedAlertActions)),
+				),
+			},
+			// the API does not return the names of the user
+			api.importStep(updateConfig, resourceTypeAndName, "first_name", "last_name"),
+		},
+	})
+}
+
 func testAccCheckUserDestroy(s *terraform.State) error {
 	apiClient := testAccProvider.Meta().(*Client)
 
@@ -124,6 +156,24 @@ data "%s" "%s" {
 
 func testAccCheckUsersUpdateConfigure(roleHCL, roleTypeAndName, resourceTypeAndName, generatedName string) string {
 	return fmt.Sprintf(`
+%s
+
+data "%s" "%s" {
+  id = "${%s.id}"
+}
+`,
+		// user resource HCL
+		getUserUpdateResourceHCL(roleHCL, roleTypeAndName, generatedName),
+
+		// data source variables
+		resourcetype.User,
+		generatedName,
+		resourceTypeAndName,
+	)
+}
+
+func getUserUpdateResourceHCL(roleHCL, roleTypeAndName, generatedName string) string {
+	return fmt.Sprintf(`
 // role
 %s
 
@@ -135,10 +185,6 @@ resource "%s" "%s" {
   role_ids             = ["${%s.id}"]
   permit_alert_actions = "%s"
 }
-
-data "%s" "%s" {
-  id = "${%s.id}"
-}
 `,
 		// role resource HCL
 		roleHCL,
@@ -152,11 +198,6 @@ data "%s" "%s" {
 		strconv.FormatBool(variable.UserIsSsoEnabled),
 		roleTypeAndName,
 		strconv.FormatBool(variable.RoleUpdateToPermittedAlertActions),
-
-		// data source variables
-		resourcetype.User,
-		generatedName,
-		resourceTypeAndName,
 	)
 }
 
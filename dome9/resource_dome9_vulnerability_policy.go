@@ -3,7 +3,6 @@ package dome9
 import (
 	"log"
 
-	"github.com/dome9/dome9-sdk-go/dome9/client"
 	"github.com/dome9/dome9-sdk-go/services/vulnerability/vulnerability_policy"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
@@ -83,7 +82,7 @@ func resourceVulnerabilityPolicyRead(d *schema.ResourceData, meta interface{}) e
 	resp, _, err := d9Client.vulnerabilityPolicy.Get(d.Id())
 
 	if err != nil {
-		if err.(*client.ErrorResponse).IsObjectNotFound() {
+		if isNotFoundError(err) {
 			log.Printf("[WARN] Removing Vulnerability policy %s from state because it no longer exists in Dome9", d.Id())
 			d.SetId("")
 			return nil
@@ -79,6 +79,49 @@ func TestAccResourceVulnerabilityPolicyPolicyBasic(t *testing.T) {
 	})
 }
 
+func TestResourceVulnerabilityPolicyLifecycle(t *testing.T) {
+	api := newFakeDome9API(t)
+	policyTypeAndName, _, policyGeneratedName := method.GenerateRandomSourcesTypeAndName(resourcetype.VulnerabilityPolicy)
+	notificationTypeAndName, _, notificationGeneratedName := method.GenerateRandomSourcesTypeAndName(resourcetype.Notification)
+	kubernetesAccountResourceTypeAndName, _, kubernetesAccountGeneratedName := method.GenerateRandomSourcesTypeAndName(resourcetype.CloudAccountKubernetes)
+
+	kubernetesAccountHCL := getCloudAccountKubernetesResourceHCLWithfeatures(kubernetesAccountGeneratedName, variable.VulnerabilityKubernetesAccountName,
+		variable.CloudAccountKubernetesRuntimeProtectionEnabled,
+		variable.CloudAccountKubernetesAdmissionControlEnabled,
+		variable.CloudAccountKubernetesImageAssuranceEnabled,
+		variable.CloudAccountKubernetesThreatIntelligenceEnabled)
+	notificationHCL := getNotificationResourceHCL(notificationGeneratedName, notificationConfig(notificationGeneratedName))
+	policyHCL := getVulnerabilityPolicyResourceHCL(kubernetesAccountHCL, kubernetesAccountResourceTypeAndName, notificationHCL,
+		notificationTypeAndName, policyGeneratedName, false)
+	updateConfig := getVulnerabilityPolicyResourceHCL(kubernetesAccountHCL, kubernetesAccountResourceTypeAndName, notificationHCL,
+		notificationTypeAndName, policyGeneratedName, true)
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    api.providers(),
+		CheckDestroy: api.checkDestroyed("vulnerability/policy"),
+		Steps: []resource.TestStep{
+			{
+				Config: api.config(testCheckVulnerabilityPolicyBasic(policyHCL, policyGeneratedName, policyTypeAndName)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(policyTypeAndName, "target_id", kubernetesAccountResourceTypeAndName, "id"),
+					resource.TestCheckResourceAttrPair(policyTypeAndName, "notification_ids.0", notificationTypeAndName, "id"),
+					resource.TestCheckResourceAttr(policyTypeAndName, "admission_control_action", variable.VulnerabilityPolicyDetectAction),
+					resource.TestCheckResourceAttr(policyTypeAndName, "admission_control_unscanned_action", variable.VulnerabilityPolicyDetectAction),
+				),
+			},
+			{
+				Config: api.config(updateConfig),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(policyTypeAndName, "target_id", kubernetesAccountResourceTypeAndName, "id"),
+					resource.TestCheckResourceAttr(policyTypeAndName, "admission_control_action", variable.VulnerabilityPolicyPreventAction),
+					resource.TestCheckResourceAttr(policyTypeAndName, "admission_control_unscanned_action", variable.VulnerabilityPolicyPreventAction),
+				),
+			},
+			api.importStep(updateConfig, policyTypeAndName),
+		},
+	})
+}
+
 func testCheckVulnerabilityPolicyExists(resource string, acPolicy *vulnerability_policy.VulnerabilityPolicyResponse) resource.TestCheckFunc {
 	return func(state *terraform.State) error {
 		rs, ok := state.RootModule().Resources[resource]
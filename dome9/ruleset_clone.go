@@ -0,0 +1,108 @@
+package dome9
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/dome9/dome9-sdk-go/services/rulebundles"
+)
+
+// ruleOverrideFields are the rule arguments a rule_override may change, an empty value keeping the one of the
+// source ruleset
+var ruleOverrideFields = []string{"name", "severity", "logic", "description", "remediation", "compliance_tag",
+	"domain", "priority", "control_title", "category"}
+
+// ruleSetRuleOverrideSchema returns the arguments of a rule_override of dome9_ruleset
+func ruleSetRuleOverrideSchema() map[string]*schema.Schema {
+	ruleSchema := ruleSetRuleSchema()
+	overrideSchema := map[string]*schema.Schema{
+		"rule_id": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+	}
+	for _, key := range ruleOverrideFields {
+		overrideSchema[key] = &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: ruleSchema[key].ValidateFunc,
+		}
+	}
+
+	return overrideSchema
+}
+
+// loadRuleSetSourceRules returns the rules of the source ruleset, without the removed rules and with the overrides
+// applied
+func loadRuleSetSourceRules(d9Client *Client, sourceID int, overrides []interface{}, removedRuleIDs *schema.Set) ([]rulebundles.Rule, error) {
+	source, _, err := d9Client.ruleSet.Get(strconv.Itoa(sourceID))
+	if err != nil {
+		return nil, fmt.Errorf("failed getting source ruleset %d: %w", sourceID, err)
+	}
+
+	return cloneRuleSetRules(sourceID, source.Rules, overrides, removedRuleIDs)
+}
+
+// cloneRuleSetRules copies rules, the rule IDs of the overrides and of the removals having to be found in them so that
+// the typos and the rules dropped from the source ruleset are reported
+func cloneRuleSetRules(sourceID int, rules []rulebundles.Rule, overrides []interface{}, removedRuleIDs *schema.Set) ([]rulebundles.Rule, error) {
+	ruleIDs := make(map[string]bool, len(rules))
+	for _, rule := range rules {
+		ruleIDs[rule.RuleID] = true
+	}
+
+	removed := make(map[string]bool)
+	if removedRuleIDs != nil {
+		for _, item := range removedRuleIDs.List() {
+			ruleID := item.(string)
+			if !ruleIDs[ruleID] {
+				return nil, fmt.Errorf("removed rule %q is not a rule of source ruleset %d", ruleID, sourceID)
+			}
+			removed[ruleID] = true
+		}
+	}
+
+	overridesByRuleID := make(map[string]map[string]interface{}, len(overrides))
+	for i, item := range overrides {
+		override := item.(map[string]interface{})
+		ruleID := override["rule_id"].(string)
+		if !ruleIDs[ruleID] {
+			return nil, fmt.Errorf("rule_override %d: %q is not a rule of source ruleset %d", i, ruleID, sourceID)
+		}
+		if removed[ruleID] {
+			return nil, fmt.Errorf("rule_override %d: rule %q is removed by removed_rule_ids", i, ruleID)
+		}
+		if _, ok := overridesByRuleID[ruleID]; ok {
+			return nil, fmt.Errorf("rule_override %d: rule %q is already overridden", i, ruleID)
+		}
+		overridesByRuleID[ruleID] = override
+	}
+
+	cloned := make([]rulebundles.Rule, 0, len(rules))
+	for _, rule := range rules {
+		if removed[rule.RuleID] {
+			continue
+		}
+
+		if override, ok := overridesByRuleID[rule.RuleID]; ok {
+			fields := flattenRule(rule)
+			for _, key := range ruleOverrideFields {
+				if value := override[key].(string); value != "" {
+					fields[key] = value
+				}
+			}
+			// Dome9 computes the logic hash of the new logic
+			if fields["logic"] != rule.Logic {
+				fields["logic_hash"] = ""
+			}
+			rule = expandRule(fields)
+		}
+		// the copies are rules of the ruleset, not of the dome9_ruleset_rule resources of the source ruleset
+		rule.Labels = nil
+		cloned = append(cloned, rule)
+	}
+
+	return cloned, nil
+}
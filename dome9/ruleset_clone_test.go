@@ -0,0 +1,81 @@
+package dome9
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/dome9/dome9-sdk-go/services/rulebundles"
+)
+
+func TestCloneRuleSetRules(t *testing.T) {
+	source := []rulebundles.Rule{
+		{Name: "first", Logic: "Instance should have vpc", Severity: "Low", RuleID: "D9.T.1", LogicHash: "h1"},
+		{Name: "second", Logic: "VPC should have cidr", Severity: "Low", RuleID: "D9.T.2", LogicHash: "h2"},
+		{Name: "third", Logic: "KMS should have rotationStatus=true", Severity: "Medium", RuleID: "D9.T.3", LogicHash: "h3"},
+		{Name: "fourth", Logic: "S3Bucket should have encryption", Severity: "High", RuleID: "D9.T.4", LogicHash: "h4", Labels: withRuleSetRuleLabel(nil)},
+	}
+	overrides := []interface{}{
+		ruleOverride("D9.T.1", "severity", "Critical"),
+		ruleOverride("D9.T.3", "logic", "KMS should have rotationStatus=true and isCustomerManaged=true"),
+	}
+
+	rules, err := cloneRuleSetRules(1, source, overrides, schema.NewSet(schema.HashString, []interface{}{"D9.T.2"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []rulebundles.Rule{
+		{Name: "first", Logic: "Instance should have vpc", Severity: "Critical", RuleID: "D9.T.1", LogicHash: "h1"},
+		{Name: "third", Logic: "KMS should have rotationStatus=true and isCustomerManaged=true", Severity: "Medium", RuleID: "D9.T.3"},
+		{Name: "fourth", Logic: "S3Bucket should have encryption", Severity: "High", RuleID: "D9.T.4", LogicHash: "h4"},
+	}
+	if len(rules) != len(want) {
+		t.Fatalf("got %d rules, want %d", len(rules), len(want))
+	}
+	for i := range rules {
+		if !reflect.DeepEqual(rules[i], want[i]) {
+			t.Errorf("rule %d: got %+v, want %+v", i, rules[i], want[i])
+		}
+	}
+	if source[0].Severity != "Low" {
+		t.Errorf("the source rules were changed")
+	}
+}
+
+func TestCloneRuleSetRulesErrors(t *testing.T) {
+	source := []rulebundles.Rule{{Name: "first", Logic: "Instance should have vpc", RuleID: "D9.T.1"}}
+	cases := []struct {
+		name      string
+		overrides []interface{}
+		removed   []interface{}
+		err       string
+	}{
+		{name: "unknown removal", removed: []interface{}{"D9.T.9"}, err: `removed rule "D9.T.9" is not a rule of source ruleset 1`},
+		{name: "unknown override", overrides: []interface{}{ruleOverride("D9.T.9", "severity", "High")}, err: `rule_override 0: "D9.T.9" is not a rule of source ruleset 1`},
+		{name: "removed override", overrides: []interface{}{ruleOverride("D9.T.1", "severity", "High")}, removed: []interface{}{"D9.T.1"}, err: "is removed by removed_rule_ids"},
+		{name: "duplicate override", overrides: []interface{}{ruleOverride("D9.T.1", "severity", "High"), ruleOverride("D9.T.1", "name", "n")}, err: "rule_override 1: rule \"D9.T.1\" is already overridden"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			_, err := cloneRuleSetRules(1, source, c.overrides, schema.NewSet(schema.HashString, c.removed))
+			if err == nil || !strings.Contains(err.Error(), c.err) {
+				t.Fatalf("got error %v, want %q", err, c.err)
+			}
+		})
+	}
+}
+
+// ruleOverride returns a rule_override of ruleID setting key to value
+func ruleOverride(ruleID, key, value string) interface{} {
+	override := map[string]interface{}{"rule_id": ruleID}
+	for _, field := range ruleOverrideFields {
+		override[field] = ""
+	}
+	override[key] = value
+
+	return override
+}
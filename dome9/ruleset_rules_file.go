@@ -0,0 +1,186 @@
+package dome9
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/zclconf/go-cty-yaml"
+	ctyjson "github.com/zclconf/go-cty/cty/json"
+
+	"github.com/dome9/dome9-sdk-go/services/rulebundles"
+	"github.com/terraform-providers/terraform-provider-dome9/dome9/common/gsl"
+)
+
+// ruleFileFields maps the fields of a rule in a rules file, in snake case as in HCL or in camel case as in the
+// rulesets exported from Dome9, to the rule arguments. The keys are lower case without underscores.
+var ruleFileFields = map[string]string{
+	"name":          "name",
+	"logic":         "logic",
+	"severity":      "severity",
+	"description":   "description",
+	"remediation":   "remediation",
+	"compliancetag": "compliance_tag",
+	"domain":        "domain",
+	"priority":      "priority",
+	"controltitle":  "control_title",
+	"ruleid":        "rule_id",
+	"category":      "category",
+	"isdefault":     "is_default",
+	// computed by Dome9, ignored so that exported rulesets can be used as they are
+	"logichash": "",
+}
+
+// loadRuleSetRulesDir loads the rules of the YAML and JSON files of dir, in the order of the file names
+func loadRuleSetRulesDir(dir string) ([]rulebundles.Rule, error) {
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed reading rules_dir %s: %w", dir, err)
+	}
+
+	var names []string
+	for _, entry := range entries {
+		if !entry.IsDir() && isRulesFile(entry.Name()) {
+			names = append(names, entry.Name())
+		}
+	}
+	sort.Strings(names)
+
+	rules := make([]rulebundles.Rule, 0)
+	for _, name := range names {
+		fileRules, err := loadRuleSetRulesFile(filepath.Join(dir, name))
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, fileRules...)
+	}
+
+	return rules, nil
+}
+
+// loadRuleSetRulesFile loads the rules of a YAML or JSON file holding a list of rules, a single rule or a
+// ruleset with a rules list
+func loadRuleSetRulesFile(path string) ([]rulebundles.Rule, error) {
+	if !isRulesFile(path) {
+		return nil, fmt.Errorf("unsupported rules file %s, expected a .yaml, .yml or .json file", path)
+	}
+
+	src, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed reading rules file %s: %w", path, err)
+	}
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		return decodeRuleSetRules(path, src)
+	}
+
+	ty, err := yaml.ImpliedType(src)
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing rules file %s: %w", path, err)
+	}
+	value, err := yaml.Unmarshal(src, ty)
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing rules file %s: %w", path, err)
+	}
+	src, err = ctyjson.Marshal(value, ty)
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing rules file %s: %w", path, err)
+	}
+
+	return decodeRuleSetRules(path, src)
+}
+
+func isRulesFile(path string) bool {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml", ".json":
+		return true
+	}
+
+	return false
+}
+
+func decodeRuleSetRules(path string, src []byte) ([]rulebundles.Rule, error) {
+	var content interface{}
+	if err := json.Unmarshal(src, &content); err != nil {
+		return nil, fmt.Errorf("failed parsing rules file %s: %w", path, err)
+	}
+
+	var items []interface{}
+	switch v := content.(type) {
+	case []interface{}:
+		items = v
+	case map[string]interface{}:
+		if rules, ok := v["rules"]; ok && v["logic"] == nil {
+			if items, ok = rules.([]interface{}); !ok {
+				return nil, fmt.Errorf("rules of rules file %s must be a list", path)
+			}
+		} else {
+			items = []interface{}{v}
+		}
+	case nil:
+	default:
+		return nil, fmt.Errorf("rules file %s must hold a list of rules, a rule or a ruleset", path)
+	}
+
+	rules := make([]rulebundles.Rule, len(items))
+	for i, item := range items {
+		rule, err := decodeRuleSetRule(item)
+		if err != nil {
+			return nil, fmt.Errorf("rule %d of rules file %s: %w", i, path, err)
+		}
+		rules[i] = rule
+	}
+
+	return rules, nil
+}
+
+func decodeRuleSetRule(item interface{}) (rulebundles.Rule, error) {
+	fields, ok := item.(map[string]interface{})
+	if !ok {
+		return rulebundles.Rule{}, fmt.Errorf("expected a mapping of the rule fields")
+	}
+
+	rule := map[string]interface{}{"severity": "Low", "is_default": false}
+	for key := range ruleSetRuleSchema() {
+		if _, ok := rule[key]; !ok {
+			rule[key] = ""
+		}
+	}
+
+	for key, value := range fields {
+		field, ok := ruleFileFields[strings.ToLower(strings.ReplaceAll(key, "_", ""))]
+		if !ok {
+			return rulebundles.Rule{}, fmt.Errorf("unknown field %q", key)
+		}
+		if field == "" || value == nil {
+			continue
+		}
+
+		if field == "is_default" {
+			isDefault, ok := value.(bool)
+			if !ok {
+				return rulebundles.Rule{}, fmt.Errorf("%s must be a boolean", key)
+			}
+			rule[field] = isDefault
+			continue
+		}
+
+		switch value.(type) {
+		case string, float64, bool:
+			rule[field] = fmt.Sprint(value)
+		default:
+			return rulebundles.Rule{}, fmt.Errorf("%s must be a string", key)
+		}
+	}
+
+	if rule["name"] == "" || rule["logic"] == "" {
+		return rulebundles.Rule{}, fmt.Errorf("name and logic are required")
+	}
+	if _, err := gsl.ParseRule(rule["logic"].(string)); err != nil {
+		return rulebundles.Rule{}, fmt.Errorf("invalid GSL in logic at %s", err)
+	}
+
+	return expandRule(rule), nil
+}
@@ -0,0 +1,118 @@
+package dome9
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/dome9/dome9-sdk-go/services/rulebundles"
+)
+
+func writeRulesFile(t *testing.T, dir, name, content string) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	if err := ioutil.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	return path
+}
+
+func TestLoadRuleSetRulesFile(t *testing.T) {
+	dir := t.TempDir()
+	cases := []struct {
+		name    string
+		content string
+		want    []rulebundles.Rule
+	}{
+		{
+			name: "list.yaml",
+			content: `
+- name: Instances should not be public
+  logic: Instance should have isPublic=false
+  severity: High
+  compliance_tag: CIS 1.1
+  priority: 1
+- name: VPCs should have flow logs
+  logic: VPC should have hasFlowLogs=true
+  is_default: true
+`,
+			want: []rulebundles.Rule{
+				{Name: "Instances should not be public", Logic: "Instance should have isPublic=false", Severity: "High", ComplianceTag: "CIS 1.1", Priority: "1"},
+				{Name: "VPCs should have flow logs", Logic: "VPC should have hasFlowLogs=true", Severity: "Low", IsDefault: true},
+			},
+		},
+		{
+			name:    "exported.json",
+			content: `{"name": "exported", "rules": [{"name": "r", "logic": "S3Bucket should have encryption", "ruleId": "D9.AWS.1", "controlTitle": "ct", "logicHash": "abc"}]}`,
+			want: []rulebundles.Rule{
+				{Name: "r", Logic: "S3Bucket should have encryption", Severity: "Low", RuleID: "D9.AWS.1", ControlTitle: "ct"},
+			},
+		},
+		{
+			name:    "single.yml",
+			content: "name: r\nlogic: KMS should have rotationStatus=true\nseverity: Critical\n",
+			want: []rulebundles.Rule{
+				{Name: "r", Logic: "KMS should have rotationStatus=true", Severity: "Critical"},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			rules, err := loadRuleSetRulesFile(writeRulesFile(t, dir, c.name, c.content))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(rules) != len(c.want) {
+				t.Fatalf("got %d rules, want %d", len(rules), len(c.want))
+			}
+			for i := range rules {
+				if !reflect.DeepEqual(rules[i], c.want[i]) {
+					t.Errorf("rule %d: got %+v, want %+v", i, rules[i], c.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestLoadRuleSetRulesFileErrors(t *testing.T) {
+	dir := t.TempDir()
+	cases := []struct {
+		name    string
+		content string
+		err     string
+	}{
+		{name: "typo.yaml", content: "- name: r\n  logic: l\n  severty: High\n", err: `rule 0 of rules file ` + filepath.Join(dir, "typo.yaml") + `: unknown field "severty"`},
+		{name: "nologic.json", content: `[{"name": "r"}]`, err: "name and logic are required"},
+		{name: "default.yaml", content: "- name: r\n  logic: l\n  is_default: yes please\n", err: "is_default must be a boolean"},
+		{name: "rules.txt", content: "", err: "unsupported rules file"},
+		{name: "gsl.yaml", content: "- name: r\n  logic: Instance should have isPublic==false\n", err: `invalid GSL in logic at line 1, column 30: unknown operator "=="`},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			_, err := loadRuleSetRulesFile(writeRulesFile(t, dir, c.name, c.content))
+			if err == nil || !strings.Contains(err.Error(), c.err) {
+				t.Fatalf("got error %v, want %q", err, c.err)
+			}
+		})
+	}
+}
+
+func TestLoadRuleSetRulesDir(t *testing.T) {
+	dir := t.TempDir()
+	writeRulesFile(t, dir, "b.yaml", "- name: second\n  logic: VPC should have cidr\n")
+	writeRulesFile(t, dir, "a.json", `[{"name": "first", "logic": "Instance should have vpc"}]`)
+	writeRulesFile(t, dir, "README.md", "not a rules file")
+
+	rules, err := loadRuleSetRulesDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rules) != 2 || rules[0].Name != "first" || rules[1].Name != "second" {
+		t.Fatalf("got %+v, want the rules of a.json then b.yaml", rules)
+	}
+}
@@ -0,0 +1,156 @@
+package dome9
+
+import (
+	"io"
+	"io/ioutil"
+	"log"
+	"math/rand"
+	"net/http"
+	"time"
+
+	"github.com/dome9/dome9-sdk-go/dome9/client"
+)
+
+const (
+	defaultMaxRetries = 3
+	defaultMinBackoff = 1 * time.Second
+	defaultMaxBackoff = 30 * time.Second
+)
+
+// Status codes retried when the provider block does not set retry_on_status
+var defaultRetryOnStatus = []int{
+	http.StatusTooManyRequests,
+	http.StatusInternalServerError,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+// retryPolicy describes when and how long the transport waits before sending a request again
+type retryPolicy struct {
+	MaxRetries    int
+	MinBackoff    time.Duration
+	MaxBackoff    time.Duration
+	RetryOnStatus []int
+}
+
+// retryTransport retries failed Dome9 API calls with a jittered exponential backoff.
+// Non-idempotent requests (POST, PATCH) are never sent again after a network error or a client error other than 429,
+// since the API may already have applied them.
+type retryTransport struct {
+	transport http.RoundTripper
+	policy    retryPolicy
+}
+
+func newRetryTransport(transport http.RoundTripper, policy retryPolicy) *retryTransport {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	if policy.MinBackoff <= 0 {
+		policy.MinBackoff = defaultMinBackoff
+	}
+	if policy.MaxBackoff < policy.MinBackoff {
+		policy.MaxBackoff = policy.MinBackoff
+	}
+	if policy.RetryOnStatus == nil {
+		policy.RetryOnStatus = defaultRetryOnStatus
+	}
+
+	return &retryTransport{transport: transport, policy: policy}
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		attemptReq := req
+		if attempt > 0 && req.Body != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			attemptReq = req.Clone(req.Context())
+			attemptReq.Body = body
+		}
+
+		resp, err := t.transport.RoundTrip(attemptReq)
+		if attempt >= t.policy.MaxRetries || !t.shouldRetry(req, resp, err) {
+			return resp, err
+		}
+
+		wait := t.backoff(attempt, resp)
+		if err != nil {
+			log.Printf("[WARN] %s %s failed: %v, retrying in %s (attempt %d/%d)", req.Method, req.URL.Path, err, wait, attempt+1, t.policy.MaxRetries)
+		} else {
+			log.Printf("[WARN] %s %s returned %s, retrying in %s (attempt %d/%d)", req.Method, req.URL.Path, resp.Status, wait, attempt+1, t.policy.MaxRetries)
+			_, _ = io.Copy(ioutil.Discard, resp.Body)
+			_ = resp.Body.Close()
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+}
+
+func (t *retryTransport) shouldRetry(req *http.Request, resp *http.Response, err error) bool {
+	// a request body that cannot be rewound cannot be sent twice
+	if req.Body != nil && req.GetBody == nil {
+		return false
+	}
+
+	if err != nil {
+		return req.Context().Err() == nil && isIdempotent(req.Method)
+	}
+
+	if !containsInt(t.policy.RetryOnStatus, resp.StatusCode) {
+		return false
+	}
+
+	isClientError := resp.StatusCode >= 400 && resp.StatusCode < 500 && resp.StatusCode != http.StatusTooManyRequests
+	return !isClientError || isIdempotent(req.Method)
+}
+
+// backoff returns the wait before the next attempt: the server's Retry-After when present,
+// otherwise min_backoff doubled per attempt with equal jitter. Both are capped at max_backoff.
+func (t *retryTransport) backoff(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if wait, ok := client.ParseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			if wait > t.policy.MaxBackoff {
+				wait = t.policy.MaxBackoff
+			}
+			return wait
+		}
+	}
+
+	wait := t.policy.MaxBackoff
+	if attempt < 32 {
+		if exp := t.policy.MinBackoff << uint(attempt); exp > 0 && exp < wait {
+			wait = exp
+		}
+	}
+
+	half := wait / 2
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+
+	return false
+}
+
+func containsInt(values []int, value int) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,212 @@
+package dome9
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/dome9/dome9-sdk-go/dome9/client"
+	"github.com/dome9/dome9-sdk-go/services/iplist"
+)
+
+func testRetryServer(t *testing.T, statuses ...int) (*httptest.Server, *int32) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		call := atomic.AddInt32(&calls, 1)
+		body, _ := ioutil.ReadAll(r.Body)
+		if r.Method == http.MethodPost && strings.TrimSpace(string(body)) != `{"name":"test"}` {
+			t.Errorf("attempt %d got body %q", call, body)
+		}
+
+		status := http.StatusOK
+		if int(call) <= len(statuses) {
+			status = statuses[call-1]
+		}
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &calls
+}
+
+func testRetryClient(maxRetries int, retryOnStatus ...int) *http.Client {
+	return &http.Client{Transport: newRetryTransport(nil, retryPolicy{
+		MaxRetries:    maxRetries,
+		MinBackoff:    time.Millisecond,
+		MaxBackoff:    5 * time.Millisecond,
+		RetryOnStatus: retryOnStatus,
+	})}
+}
+
+func TestRetryTransport(t *testing.T) {
+	cases := []struct {
+		name          string
+		method        string
+		statuses      []int
+		retryOnStatus []int
+		wantStatus    int
+		wantCalls     int32
+	}{
+		{"success", http.MethodGet, nil, nil, http.StatusOK, 1},
+		{"throttled then success", http.MethodGet, []int{429, 503}, nil, http.StatusOK, 3},
+		{"gives up after max retries", http.MethodGet, []int{500, 500, 500, 500, 500}, nil, http.StatusInternalServerError, 4},
+		{"status not retried by default", http.MethodGet, []int{400}, nil, http.StatusBadRequest, 1},
+		{"configured client error retried on GET", http.MethodGet, []int{409}, []int{409}, http.StatusOK, 2},
+		{"configured client error not retried on POST", http.MethodPost, []int{409}, []int{409}, http.StatusConflict, 1},
+		{"throttled POST retried", http.MethodPost, []int{429}, nil, http.StatusOK, 2},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			server, calls := testRetryServer(t, tc.statuses...)
+			req, err := http.NewRequest(tc.method, server.URL, strings.NewReader(`{"name":"test"}`))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			resp, err := testRetryClient(3, tc.retryOnStatus...).Do(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			_ = resp.Body.Close()
+
+			if resp.StatusCode != tc.wantStatus {
+				t.Errorf("got status %d, want %d", resp.StatusCode, tc.wantStatus)
+			}
+			if got := atomic.LoadInt32(calls); got != tc.wantCalls {
+				t.Errorf("got %d calls, want %d", got, tc.wantCalls)
+			}
+		})
+	}
+}
+
+func TestRetryTransportBackoff(t *testing.T) {
+	transport := newRetryTransport(nil, retryPolicy{MinBackoff: time.Second, MaxBackoff: 10 * time.Second})
+	for attempt, ceiling := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second, 10 * time.Second, 10 * time.Second} {
+		wait := transport.backoff(attempt, nil)
+		if wait < ceiling/2 || wait > ceiling {
+			t.Errorf("attempt %d: backoff %s outside [%s, %s]", attempt, wait, ceiling/2, ceiling)
+		}
+	}
+
+	resp := &http.Response{Header: http.Header{"Retry-After": []string{"7"}}}
+	if wait := transport.backoff(0, resp); wait != 7*time.Second {
+		t.Errorf("got %s, want Retry-After of 7s", wait)
+	}
+
+	resp = &http.Response{Header: http.Header{"Retry-After": []string{"3600"}}}
+	if wait := transport.backoff(0, resp); wait != 10*time.Second {
+		t.Errorf("got %s, want Retry-After of 3600s capped at max_backoff of 10s", wait)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	if _, ok := client.ParseRetryAfter(""); ok {
+		t.Error("empty header parsed")
+	}
+	if _, ok := client.ParseRetryAfter("soon"); ok {
+		t.Error("invalid header parsed")
+	}
+	if wait, ok := client.ParseRetryAfter("7"); !ok || wait != 7*time.Second {
+		t.Errorf("got %s, %v, want 7s", wait, ok)
+	}
+
+	date := time.Now().Add(time.Minute).UTC().Format(http.TimeFormat)
+	if wait, ok := client.ParseRetryAfter(date); !ok || wait <= 0 || wait > time.Minute {
+		t.Errorf("got %s, %v for HTTP-date %s", wait, ok, date)
+	}
+}
+
+func testClient(t *testing.T, stopCtx context.Context, baseURL string, policy retryPolicy) *Client {
+	config := Config{
+		AccessID:    "access-id",
+		SecretKey:   "secret",
+		BaseURL:     baseURL + "/v2/",
+		RetryPolicy: policy,
+		StopContext: stopCtx,
+		// the tests count the calls of the services only
+		SkipCredentialsValidation: true,
+	}
+	client, err := config.Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return client
+}
+
+// The services of the SDK send each request once, the retry transport is the only one retrying
+func TestClientRetries(t *testing.T) {
+	cases := []struct {
+		name     string
+		policy   retryPolicy
+		statuses []int
+		post     bool
+		calls    int32
+	}{
+		{"retries disabled", retryPolicy{MaxRetries: 0}, []int{http.StatusServiceUnavailable}, false, 1},
+		{"retried", retryPolicy{MaxRetries: 3, MinBackoff: time.Millisecond}, []int{http.StatusServiceUnavailable, http.StatusServiceUnavailable}, false, 3},
+		{"post client error", retryPolicy{MaxRetries: 3, MinBackoff: time.Millisecond, RetryOnStatus: []int{http.StatusBadRequest}}, []int{http.StatusBadRequest}, true, 1},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			server, calls := testRetryServer(t, c.statuses...)
+			client := testClient(t, context.Background(), server.URL, c.policy)
+			if c.post {
+				_, _, _ = client.iplist.Create(&iplist.IpList{Name: "test"})
+			} else {
+				_, _, _ = client.iplist.Get(1)
+			}
+			if got := atomic.LoadInt32(calls); got != c.calls {
+				t.Errorf("got %d calls, want %d", got, c.calls)
+			}
+		})
+	}
+}
+
+func TestClientStopContextCancelsRetryWait(t *testing.T) {
+	server, calls := testRetryServer(t, http.StatusServiceUnavailable, http.StatusServiceUnavailable)
+	stopCtx, stop := context.WithCancel(context.Background())
+	client := testClient(t, stopCtx, server.URL, retryPolicy{MaxRetries: 3, MinBackoff: time.Hour, MaxBackoff: time.Hour})
+	ctx, cancel := client.requestContext(time.Hour)
+	defer cancel()
+
+	time.AfterFunc(50*time.Millisecond, stop)
+	start := time.Now()
+	_, _, err := client.iplist.GetWithContext(ctx, 1)
+	if err == nil || !errors.Is(err, context.Canceled) {
+		t.Fatalf("got error %v, want context canceled", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("request returned after %s, want right after cancellation", elapsed)
+	}
+	if got := atomic.LoadInt32(calls); got != 1 {
+		t.Errorf("got %d calls, want 1", got)
+	}
+}
+
+func TestClientRequestTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	t.Cleanup(server.Close)
+	client := testClient(t, context.Background(), server.URL, retryPolicy{})
+	ctx, cancel := client.requestContext(50 * time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, _, err := client.iplist.GetWithContext(ctx, 1)
+	if err == nil || !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("got error %v, want context deadline exceeded", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("request returned after %s, want right after the timeout", elapsed)
+	}
+}
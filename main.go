@@ -1,13 +1,64 @@
 package main
 
 import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
 	"github.com/hashicorp/terraform-plugin-sdk/plugin"
 
 	"github.com/terraform-providers/terraform-provider-dome9/dome9"
+	"github.com/terraform-providers/terraform-provider-dome9/dome9/common/providerconst"
 )
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "export" {
+		if err := export(os.Args[2:]); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	plugin.Serve(&plugin.ServeOpts{
 		ProviderFunc: dome9.Provider,
 	})
 }
+
+// export writes the Terraform configuration of the resources of the Dome9 account, the credentials being read as by
+// the provider from the environment variables or the shared credentials file
+func export(args []string) error {
+	flags := flag.NewFlagSet("export", flag.ContinueOnError)
+	dir := flags.String("dir", ".", "directory the .tf files are written to")
+	types := flags.String("types", "", "comma separated resource types to export, all when empty")
+	profile := flags.String("profile", "", "profile of the shared credentials file")
+	region := flags.String("region", "", "CloudGuard data center the API calls are sent to")
+	baseURL := flags.String("base-url", "", "Dome9 base url, conflicting with -region")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	providerConfig := make(map[string]interface{})
+	for key, value := range map[string]string{
+		providerconst.ProviderProfile: *profile,
+		providerconst.ProviderRegion:  *region,
+		providerconst.ProviderBaseURL: *baseURL,
+	} {
+		if value != "" {
+			providerConfig[key] = value
+		}
+	}
+
+	var exportedTypes []string
+	if *types != "" {
+		exportedTypes = strings.Split(*types, ",")
+	}
+
+	return dome9.Export(dome9.ExportConfig{
+		Dir:            *dir,
+		Types:          exportedTypes,
+		ProviderConfig: providerConfig,
+		Log:            os.Stdout,
+	})
+}
@@ -0,0 +1,151 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type ErrorResponse struct {
+	Response *http.Response
+	Message  string
+}
+
+func (r *ErrorResponse) Error() string {
+	return fmt.Sprintf("FAILED: %v, %v, %d, %v, %v", r.Response.Request.Method, r.Response.Request.URL, r.Response.StatusCode, r.Response.Status, r.Message)
+}
+
+func checkErrorInResponse(res *http.Response) error {
+	if c := res.StatusCode; c >= 200 && c <= 299 {
+		return nil
+	}
+	errorResponse := &ErrorResponse{Response: res}
+	errorMessage, err := ioutil.ReadAll(res.Body)
+	if err == nil && len(errorMessage) > 0 {
+		errorResponse.Message = string(errorMessage)
+	}
+	return newAPIError(errorResponse)
+}
+
+// IsObjectNotFound returns true on missing object error (404).
+func (r ErrorResponse) IsObjectNotFound() bool {
+	return r.Response.StatusCode == 404
+}
+
+// APIError is a failed API call with its decoded error body. Use errors.As with *APIError to match
+// any API error, or with one of the typed errors below to match a specific failure.
+// The typed errors unwrap to the *ErrorResponse of the call.
+type APIError struct {
+	StatusCode int
+	// Message is the message of the error body, or the raw body when it is not JSON
+	Message string
+	// Fields holds the per-field messages of validation errors
+	Fields map[string][]string
+
+	// err is nil for the errors built by the callers, e.g. an object missing from a list
+	err *ErrorResponse
+}
+
+func (e *APIError) Error() string {
+	if e.err == nil {
+		return e.Message
+	}
+
+	return e.err.Error()
+}
+
+func (e *APIError) Unwrap() error {
+	if e.err == nil {
+		return nil
+	}
+
+	return e.err
+}
+
+// As lets errors.As match the typed errors embedding APIError with a *APIError target
+func (e *APIError) As(target interface{}) bool {
+	if t, ok := target.(**APIError); ok {
+		*t = e
+		return true
+	}
+
+	return false
+}
+
+// NotFoundError is returned for 404 responses
+type NotFoundError struct{ APIError }
+
+// UnauthorizedError is returned for 401 and 403 responses
+type UnauthorizedError struct{ APIError }
+
+// ConflictError is returned for 409 responses
+type ConflictError struct{ APIError }
+
+// ThrottledError is returned for 429 responses
+type ThrottledError struct {
+	APIError
+	// RetryAfter is the wait requested by the API, zero when it did not send a Retry-After header
+	RetryAfter time.Duration
+}
+
+// ValidationError is returned for 400 and 422 responses
+type ValidationError struct{ APIError }
+
+// apiErrorBody matches the error bodies of the API, e.g. {"Message":"The request is invalid.","ModelState":{"name":["required"]}}
+type apiErrorBody struct {
+	Message    string              `json:"message"`
+	ModelState map[string][]string `json:"modelState"`
+}
+
+// newAPIError converts the error response of a call into one of the typed errors
+func newAPIError(errResp *ErrorResponse) error {
+	base := APIError{StatusCode: errResp.Response.StatusCode, Message: strings.TrimSpace(errResp.Message), err: errResp}
+	var body apiErrorBody
+	if json.Unmarshal([]byte(errResp.Message), &body) == nil {
+		if body.Message != "" {
+			base.Message = body.Message
+		}
+		base.Fields = body.ModelState
+	}
+
+	switch base.StatusCode {
+	case http.StatusNotFound:
+		return &NotFoundError{base}
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return &UnauthorizedError{base}
+	case http.StatusConflict:
+		return &ConflictError{base}
+	case http.StatusTooManyRequests:
+		retryAfter, _ := ParseRetryAfter(errResp.Response.Header.Get("Retry-After"))
+		return &ThrottledError{APIError: base, RetryAfter: retryAfter}
+	case http.StatusBadRequest, http.StatusUnprocessableEntity:
+		return &ValidationError{base}
+	}
+
+	return &base
+}
+
+// ParseRetryAfter supports both forms of the Retry-After header: delay-seconds and HTTP-date
+func ParseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		wait := time.Until(date)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+
+	return 0, false
+}
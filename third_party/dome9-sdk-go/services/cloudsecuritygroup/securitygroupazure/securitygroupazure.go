@@ -0,0 +1,143 @@
+package securitygroupazure
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+const (
+	azureSgResourcePath = "AzureSecurityGroupPolicy"
+)
+
+type AzureSecurityGroupRequest struct {
+	Name              string         `json:"name"`
+	Region            string         `json:"region"`
+	ResourceGroup     string         `json:"resourceGroup"`
+	CloudAccountID    string         `json:"cloudAccountId"`
+	Description       string         `json:"description"`
+	IsTamperProtected bool           `json:"isTamperProtected"`
+	Tags              []Tags         `json:"tags,omitempty"`
+	InboundServices   []BoundService `json:"inboundServices,omitempty"`
+	OutboundServices  []BoundService `json:"outboundServices,omitempty"`
+}
+
+type AzureSecurityGroupResponse struct {
+	ID                string         `json:"id"`
+	Name              string         `json:"name"`
+	Region            string         `json:"region"`
+	ResourceGroup     string         `json:"resourceGroup"`
+	CloudAccountID    string         `json:"cloudAccountId"`
+	Description       string         `json:"description"`
+	IsTamperProtected bool           `json:"isTamperProtected"`
+	Tags              []Tags         `json:"tags"`
+	InboundServices   []BoundService `json:"inboundServices"`
+	OutboundServices  []BoundService `json:"outboundServices"`
+
+	ExternalSecurityGroupID string `json:"externalSecurityGroupId"`
+	AccountID               int    `json:"accountId"`
+	CloudAccountName        string `json:"cloudAccountName"`
+	LastUpdatedByDome9      bool   `json:"lastUpdatedByDome9"`
+	Error                   Error  `json:"error"`
+}
+
+type Tags struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+type Scope struct {
+	Type string                 `json:"type"`
+	Data map[string]interface{} `json:"data"`
+}
+
+type BoundService struct {
+	Name                  string   `json:"name"`
+	Description           string   `json:"description"`
+	Priority              int      `json:"priority"`
+	Access                string   `json:"access"`
+	Protocol              string   `json:"protocol"`
+	Direction             string   `json:"direction"`
+	SourcePortRanges      []string `json:"sourcePortRanges"`
+	SourceScopes          []Scope  `json:"sourceScopes"`
+	DestinationPortRanges []string `json:"destinationPortRanges"`
+	DestinationScopes     []Scope  `json:"destinationScopes"`
+	IsDefault             bool     `json:"isDefault"`
+}
+
+type Error struct {
+	Action       string `json:"action"`
+	ErrorMessage string `json:"errorMessage"`
+}
+
+func (service *Service) Get(id string) (*AzureSecurityGroupResponse, *http.Response, error) {
+	return service.GetWithContext(context.Background(), id)
+}
+
+func (service *Service) GetWithContext(ctx context.Context, id string) (*AzureSecurityGroupResponse, *http.Response, error) {
+	v := new(AzureSecurityGroupResponse)
+	relativeURL := fmt.Sprintf("%s/%s", azureSgResourcePath, id)
+	resp, err := service.Client.NewRequestDoRetryWithContext(ctx, "GET", relativeURL, nil, nil, v, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v, resp, nil
+}
+
+func (service *Service) GetAll() (*[]AzureSecurityGroupResponse, *http.Response, error) {
+	return service.GetAllWithContext(context.Background())
+}
+
+func (service *Service) GetAllWithContext(ctx context.Context) (*[]AzureSecurityGroupResponse, *http.Response, error) {
+	v := new([]AzureSecurityGroupResponse)
+	resp, err := service.Client.NewRequestDoRetryWithContext(ctx, "GET", azureSgResourcePath, nil, nil, v, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v, resp, nil
+}
+
+func (service *Service) Create(body AzureSecurityGroupRequest) (*AzureSecurityGroupResponse, *http.Response, error) {
+	return service.CreateWithContext(context.Background(), body)
+}
+
+func (service *Service) CreateWithContext(ctx context.Context, body AzureSecurityGroupRequest) (*AzureSecurityGroupResponse, *http.Response, error) {
+	v := new(AzureSecurityGroupResponse)
+	resp, err := service.Client.NewRequestDoRetryWithContext(ctx, "POST", azureSgResourcePath, nil, body, v, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v, resp, nil
+}
+
+func (service *Service) Delete(id string) (*http.Response, error) {
+	return service.DeleteWithContext(context.Background(), id)
+}
+
+func (service *Service) DeleteWithContext(ctx context.Context, id string) (*http.Response, error) {
+	relativeURL := fmt.Sprintf("%s/%s", azureSgResourcePath, id)
+	resp, err := service.Client.NewRequestDoRetryWithContext(ctx, "DELETE", relativeURL, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (service *Service) Update(id string, body AzureSecurityGroupRequest) (*AzureSecurityGroupResponse, *http.Response, error) {
+	return service.UpdateWithContext(context.Background(), id, body)
+}
+
+func (service *Service) UpdateWithContext(ctx context.Context, id string, body AzureSecurityGroupRequest) (*AzureSecurityGroupResponse, *http.Response, error) {
+	v := new(AzureSecurityGroupResponse)
+	relativeURL := fmt.Sprintf("%s/%s", azureSgResourcePath, id)
+	resp, err := service.Client.NewRequestDoRetryWithContext(ctx, "PUT", relativeURL, nil, body, v, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v, resp, nil
+}
@@ -0,0 +1,107 @@
+package compliance_exclusion
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/dome9/dome9-sdk-go/dome9/client"
+	"github.com/dome9/dome9-sdk-go/services/assessment"
+)
+
+const (
+	complianceExclusionResourcePath = "Compliance/Exclusion"
+)
+
+// ComplianceExclusion is assessment.Exclusion with an optional date range, as sent to and returned by the API
+type ComplianceExclusion struct {
+	ID                    assessment.ExclusionOrRemediationID     `json:"id,omitempty"`
+	Platform              string                                  `json:"platform,omitempty"`
+	Rules                 []assessment.ExclusionOrRemediationRule `json:"rules"`
+	LogicExpressions      []string                                `json:"logicExpressions"`
+	RulesetId             int                                     `json:"rulesetId"`
+	CloudAccountIds       []string                                `json:"cloudAccountIds"`
+	Comment               string                                  `json:"comment"`
+	OrganizationalUnitIds []string                                `json:"organizationalUnitIds"`
+	DateRange             *assessment.Date                        `json:"dateRange,omitempty"`
+}
+
+func (service *Service) Create(body *ComplianceExclusion) (*ComplianceExclusion, *http.Response, error) {
+	return service.CreateWithContext(context.Background(), body)
+}
+
+func (service *Service) CreateWithContext(ctx context.Context, body *ComplianceExclusion) (*ComplianceExclusion, *http.Response, error) {
+	v := new(ComplianceExclusion)
+	resp, err := service.Client.NewRequestDoWithContext(ctx, "POST", complianceExclusionResourcePath, nil, body, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v, resp, nil
+}
+
+func (service *Service) GetAll() ([]ComplianceExclusion, *http.Response, error) {
+	return service.GetAllWithContext(context.Background())
+}
+
+func (service *Service) GetAllWithContext(ctx context.Context) ([]ComplianceExclusion, *http.Response, error) {
+	var v []ComplianceExclusion
+	resp, err := service.Client.NewRequestDoWithContext(ctx, "GET", complianceExclusionResourcePath, nil, nil, &v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v, resp, nil
+}
+
+// Get finds the exclusion in the list returned by GetAll, the API has no call returning a single exclusion.
+// A missing exclusion is reported as a NotFoundError.
+func (service *Service) Get(id string) (*ComplianceExclusion, *http.Response, error) {
+	return service.GetWithContext(context.Background(), id)
+}
+
+func (service *Service) GetWithContext(ctx context.Context, id string) (*ComplianceExclusion, *http.Response, error) {
+	exclusions, resp, err := service.GetAllWithContext(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for i := range exclusions {
+		if strings.EqualFold(string(exclusions[i].ID), id) {
+			return &exclusions[i], resp, nil
+		}
+	}
+
+	return nil, resp, &client.NotFoundError{APIError: client.APIError{StatusCode: http.StatusNotFound, Message: fmt.Sprintf("compliance exclusion %s not found", id)}}
+}
+
+func (service *Service) Update(id string, body *ComplianceExclusion) (*ComplianceExclusion, *http.Response, error) {
+	return service.UpdateWithContext(context.Background(), id, body)
+}
+
+func (service *Service) UpdateWithContext(ctx context.Context, id string, body *ComplianceExclusion) (*ComplianceExclusion, *http.Response, error) {
+	v := new(ComplianceExclusion)
+	body.ID = assessment.ExclusionOrRemediationID(id)
+	relativeURL := fmt.Sprintf("%s/%s", complianceExclusionResourcePath, id)
+	resp, err := service.Client.NewRequestDoWithContext(ctx, "PUT", relativeURL, nil, body, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v, resp, nil
+}
+
+func (service *Service) Delete(id string) (*http.Response, error) {
+	return service.DeleteWithContext(context.Background(), id)
+}
+
+func (service *Service) DeleteWithContext(ctx context.Context, id string) (*http.Response, error) {
+	relativeURL := fmt.Sprintf("%s/%s", complianceExclusionResourcePath, id)
+	resp, err := service.Client.NewRequestDoWithContext(ctx, "DELETE", relativeURL, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
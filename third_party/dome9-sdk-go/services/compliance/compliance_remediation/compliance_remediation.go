@@ -0,0 +1,108 @@
+package compliance_remediation
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/dome9/dome9-sdk-go/dome9/client"
+	"github.com/dome9/dome9-sdk-go/services/assessment"
+)
+
+const (
+	complianceRemediationResourcePath = "Compliance/Remediation"
+)
+
+// ComplianceRemediation is assessment.Remediation with an optional date range, as sent to and returned by the API
+type ComplianceRemediation struct {
+	ID                    assessment.ExclusionOrRemediationID     `json:"id,omitempty"`
+	Platform              string                                  `json:"platform,omitempty"`
+	Rules                 []assessment.ExclusionOrRemediationRule `json:"rules"`
+	LogicExpressions      []string                                `json:"logicExpressions"`
+	RulesetId             int                                     `json:"rulesetId"`
+	CloudAccountIds       []string                                `json:"cloudAccountIds"`
+	Comment               string                                  `json:"comment"`
+	CloudBots             []string                                `json:"cloudBots"`
+	OrganizationalUnitIds []string                                `json:"organizationalUnitIds"`
+	DateRange             *assessment.Date                        `json:"dateRange,omitempty"`
+}
+
+func (service *Service) Create(body *ComplianceRemediation) (*ComplianceRemediation, *http.Response, error) {
+	return service.CreateWithContext(context.Background(), body)
+}
+
+func (service *Service) CreateWithContext(ctx context.Context, body *ComplianceRemediation) (*ComplianceRemediation, *http.Response, error) {
+	v := new(ComplianceRemediation)
+	resp, err := service.Client.NewRequestDoWithContext(ctx, "POST", complianceRemediationResourcePath, nil, body, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v, resp, nil
+}
+
+func (service *Service) GetAll() ([]ComplianceRemediation, *http.Response, error) {
+	return service.GetAllWithContext(context.Background())
+}
+
+func (service *Service) GetAllWithContext(ctx context.Context) ([]ComplianceRemediation, *http.Response, error) {
+	var v []ComplianceRemediation
+	resp, err := service.Client.NewRequestDoWithContext(ctx, "GET", complianceRemediationResourcePath, nil, nil, &v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v, resp, nil
+}
+
+// Get finds the remediation in the list returned by GetAll, the API has no call returning a single remediation.
+// A missing remediation is reported as a NotFoundError.
+func (service *Service) Get(id string) (*ComplianceRemediation, *http.Response, error) {
+	return service.GetWithContext(context.Background(), id)
+}
+
+func (service *Service) GetWithContext(ctx context.Context, id string) (*ComplianceRemediation, *http.Response, error) {
+	remediations, resp, err := service.GetAllWithContext(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for i := range remediations {
+		if strings.EqualFold(string(remediations[i].ID), id) {
+			return &remediations[i], resp, nil
+		}
+	}
+
+	return nil, resp, &client.NotFoundError{APIError: client.APIError{StatusCode: http.StatusNotFound, Message: fmt.Sprintf("compliance remediation %s not found", id)}}
+}
+
+func (service *Service) Update(id string, body *ComplianceRemediation) (*ComplianceRemediation, *http.Response, error) {
+	return service.UpdateWithContext(context.Background(), id, body)
+}
+
+func (service *Service) UpdateWithContext(ctx context.Context, id string, body *ComplianceRemediation) (*ComplianceRemediation, *http.Response, error) {
+	v := new(ComplianceRemediation)
+	body.ID = assessment.ExclusionOrRemediationID(id)
+	relativeURL := fmt.Sprintf("%s/%s", complianceRemediationResourcePath, id)
+	resp, err := service.Client.NewRequestDoWithContext(ctx, "PUT", relativeURL, nil, body, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v, resp, nil
+}
+
+func (service *Service) Delete(id string) (*http.Response, error) {
+	return service.DeleteWithContext(context.Background(), id)
+}
+
+func (service *Service) DeleteWithContext(ctx context.Context, id string) (*http.Response, error) {
+	relativeURL := fmt.Sprintf("%s/%s", complianceRemediationResourcePath, id)
+	resp, err := service.Client.NewRequestDoWithContext(ctx, "DELETE", relativeURL, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,104 @@
+package roles
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+const (
+	roleResourcePath = "role"
+)
+
+type RoleRequest struct {
+	Name        string      `json:"name"`
+	Description string      `json:"description"`
+	Permissions Permissions `json:"permissions"`
+}
+
+type RoleResponse struct {
+	ID          int         `json:"id"`
+	Name        string      `json:"name"`
+	Description string      `json:"description"`
+	Permissions Permissions `json:"permissions"`
+}
+
+type Permissions struct {
+	Access             []string `json:"access"`
+	Manage             []string `json:"manage"`
+	Rulesets           []string `json:"rulesets"`
+	Notifications      []string `json:"notifications"`
+	Policies           []string `json:"policies"`
+	AlertActions       []string `json:"alertActions"`
+	Create             []string `json:"create"`
+	View               []string `json:"view"`
+	OnBoarding         []string `json:"onBoarding"`
+	CrossAccountAccess []string `json:"crossAccountAccess"`
+}
+
+func (service *Service) GetAll() (*[]RoleResponse, *http.Response, error) {
+	return service.GetAllWithContext(context.Background())
+}
+
+func (service *Service) GetAllWithContext(ctx context.Context) (*[]RoleResponse, *http.Response, error) {
+	v := new([]RoleResponse)
+	resp, err := service.Client.NewRequestDoRetryWithContext(ctx, "GET", roleResourcePath, nil, nil, v, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	return v, resp, nil
+}
+
+func (service *Service) Get(id string) (*RoleResponse, *http.Response, error) {
+	return service.GetWithContext(context.Background(), id)
+}
+
+func (service *Service) GetWithContext(ctx context.Context, id string) (*RoleResponse, *http.Response, error) {
+	v := new(RoleResponse)
+	relativeURL := fmt.Sprintf("%s/%s", roleResourcePath, id)
+	resp, err := service.Client.NewRequestDoRetryWithContext(ctx, "GET", relativeURL, nil, nil, v, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v, resp, nil
+}
+
+func (service *Service) Create(role RoleRequest) (*RoleResponse, *http.Response, error) {
+	return service.CreateWithContext(context.Background(), role)
+}
+
+func (service *Service) CreateWithContext(ctx context.Context, role RoleRequest) (*RoleResponse, *http.Response, error) {
+	v := new(RoleResponse)
+	resp, err := service.Client.NewRequestDoRetryWithContext(ctx, "POST", roleResourcePath, nil, role, &v, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	return v, resp, nil
+}
+
+func (service *Service) Update(id string, roleRequest RoleRequest) (*http.Response, error) {
+	return service.UpdateWithContext(context.Background(), id, roleRequest)
+}
+
+func (service *Service) UpdateWithContext(ctx context.Context, id string, roleRequest RoleRequest) (*http.Response, error) {
+	path := fmt.Sprintf("%s/%s", roleResourcePath, id)
+	resp, err := service.Client.NewRequestDoRetryWithContext(ctx, "PUT", path, nil, roleRequest, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return resp, err
+}
+
+func (service *Service) Delete(id string) (*http.Response, error) {
+	return service.DeleteWithContext(context.Background(), id)
+}
+
+func (service *Service) DeleteWithContext(ctx context.Context, id string) (*http.Response, error) {
+	path := fmt.Sprintf("%s/%s", roleResourcePath, id)
+	resp, err := service.Client.NewRequestDoRetryWithContext(ctx, "DELETE", path, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return resp, err
+}
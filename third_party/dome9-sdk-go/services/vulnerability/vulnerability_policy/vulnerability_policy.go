@@ -0,0 +1,118 @@
+package vulnerability_policy
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+const (
+	vulnerabilityPolicyResourcePath = "vulnerability/policy"
+)
+
+type VulnerabilityPolicyRequest struct {
+	TargetId                        string   `json:"targetId"`
+	TargetType                      string   `json:"targetType"`
+	NotificationIds                 []string `json:"notificationIds"`
+	RulesetId                       int      `json:"rulesetId"`
+	AdmissionControllerAction       string   `json:"admissionControllerAction,omitempty"`
+	AdmissionControlUnScannedAction string   `json:"admissionControlUnScannedAction,omitempty"`
+}
+
+type VulnerabilityPolicyResponse struct {
+	ID                              string   `json:"id"`
+	TargetId                        string   `json:"targetId"`
+	TargetType                      string   `json:"targetType"`
+	NotificationIds                 []string `json:"notificationIds"`
+	RulesetId                       int      `json:"rulesetId"`
+	AdmissionControllerAction       string   `json:"admissionControllerAction"`
+	AdmissionControlUnScannedAction string   `json:"admissionControlUnScannedAction"`
+	ErrorMessage                    string   `json:"errorMessage"`
+}
+
+type VulnerabilityPolicyDeleteQueryString struct {
+	ID                           string `json:"id"`
+}
+
+func (service *Service) Get(id string) (*VulnerabilityPolicyResponse, *http.Response, error) {
+	return service.GetWithContext(context.Background(), id)
+}
+
+func (service *Service) GetWithContext(ctx context.Context, id string) (*VulnerabilityPolicyResponse, *http.Response, error) {
+	v := new(VulnerabilityPolicyResponse)
+	path := fmt.Sprintf("%s/%s", vulnerabilityPolicyResourcePath, id)
+	resp, err := service.Client.NewRequestDoRetryWithContext(ctx, "GET", path, nil, nil, v, shouldRetry)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v, resp, nil
+}
+
+func (service *Service) GetAll() (*[]VulnerabilityPolicyResponse, *http.Response, error) {
+	return service.GetAllWithContext(context.Background())
+}
+
+func (service *Service) GetAllWithContext(ctx context.Context) (*[]VulnerabilityPolicyResponse, *http.Response, error) {
+	v := new([]VulnerabilityPolicyResponse)
+	resp, err := service.Client.NewRequestDoRetryWithContext(ctx, "GET", vulnerabilityPolicyResourcePath, nil, nil, v, shouldRetry)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v, resp, nil
+}
+
+func (service *Service) Create(body *VulnerabilityPolicyRequest) (*VulnerabilityPolicyResponse, *http.Response, error) {
+	return service.CreateWithContext(context.Background(), body)
+}
+
+func (service *Service) CreateWithContext(ctx context.Context, body *VulnerabilityPolicyRequest) (*VulnerabilityPolicyResponse, *http.Response, error) {
+	v := new([]VulnerabilityPolicyResponse)
+	resp, err := service.Client.NewRequestDoRetryWithContext(ctx, "POST", vulnerabilityPolicyResourcePath, nil, []*VulnerabilityPolicyRequest{body}, v, shouldRetry)
+	if err != nil {
+		return nil, nil, err
+	}
+	policy := new(VulnerabilityPolicyResponse)
+	if len(*v) > 0 {
+		policy = &(*v)[0]
+	}
+	return policy, resp, nil
+}
+
+func (service *Service) Update(body *VulnerabilityPolicyRequest) (*VulnerabilityPolicyResponse, *http.Response, error) {
+	return service.UpdateWithContext(context.Background(), body)
+}
+
+func (service *Service) UpdateWithContext(ctx context.Context, body *VulnerabilityPolicyRequest) (*VulnerabilityPolicyResponse, *http.Response, error) {
+	v := new([]VulnerabilityPolicyResponse)
+	resp, err := service.Client.NewRequestDoRetryWithContext(ctx, "PUT", vulnerabilityPolicyResourcePath, nil, []*VulnerabilityPolicyRequest{body}, v, shouldRetry)
+	if err != nil {
+		return nil, nil, err
+	}
+	policy := new(VulnerabilityPolicyResponse)
+	if len(*v) > 0 {
+		policy = &(*v)[0]
+	}
+	return policy, resp, nil
+}
+
+func (service *Service) Delete(id string) (*http.Response, error) {
+	return service.DeleteWithContext(context.Background(), id)
+}
+
+func (service *Service) DeleteWithContext(ctx context.Context, id string) (*http.Response, error) {
+	queryString := VulnerabilityPolicyDeleteQueryString{
+		ID: id,
+	}
+	resp, err := service.Client.NewRequestDoRetryWithContext(ctx, "DELETE", vulnerabilityPolicyResourcePath, queryString, nil, nil, shouldRetry)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func shouldRetry(resp *http.Response) bool {
+	return resp != nil && resp.StatusCode == http.StatusNotFound
+}